@@ -20,7 +20,7 @@ require (
 )
 
 require (
-	k8s.io/api v0.26.0 // indirect
+	k8s.io/api v0.26.0
 	k8s.io/apimachinery v0.26.0
 	k8s.io/cli-runtime v0.25.2
 	k8s.io/client-go v12.0.0+incompatible
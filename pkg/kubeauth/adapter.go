@@ -0,0 +1,103 @@
+package kubeauth
+
+import (
+	"context"
+
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+	"google.golang.org/grpc"
+	"k8s.io/client-go/rest"
+	clientcmd "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// pluginAdapter adapts the deprecated Plugin interface to PluginV2
+type pluginAdapter struct {
+	plugin Plugin
+}
+
+// NewPluginAdapter returns a PluginV2 for a plugin implementing the
+// deprecated Plugin interface. The deprecated interface does not take a
+// context, so the plugin calls cannot be cancelled. They are always waited
+// for, and the context error is returned if the context is done by the
+// time they complete
+func NewPluginAdapter(p Plugin) PluginV2 {
+	return &pluginAdapter{plugin: p}
+}
+
+func (a *pluginAdapter) UpdateClient(
+	ctx context.Context,
+	conn *grpc.ClientConn,
+	cloudCredentialName string,
+	cloudCredentialUID string,
+	orgID string,
+	restConfig *rest.Config,
+	clientConfig *clientcmd.Config,
+) (bool, string, error) {
+	if err := ctx.Err(); err != nil {
+		return false, "", err
+	}
+	return a.plugin.UpdateClient(conn, ctx, cloudCredentialName, cloudCredentialUID, orgID, restConfig, clientConfig)
+}
+
+func (a *pluginAdapter) UpdateClientByCredObject(
+	ctx context.Context,
+	cloudCred *api.CloudCredentialObject,
+	restConfig *rest.Config,
+	clientConfig *clientcmd.Config,
+) (bool, string, error) {
+	if err := ctx.Err(); err != nil {
+		return false, "", err
+	}
+	return a.plugin.UpdateClientByCredObject(cloudCred, restConfig, clientConfig)
+}
+
+func (a *pluginAdapter) GetClient(
+	ctx context.Context,
+	cloudCred *api.CloudCredentialObject,
+	clusterName string,
+	region string,
+) (*PluginClient, error) {
+	var client *PluginClient
+	if err := RunWithContext(ctx, func(context.Context) error {
+		var err error
+		client, err = a.plugin.GetClient(cloudCred, clusterName, region)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func (a *pluginAdapter) GetAllClients(
+	ctx context.Context,
+	cloudCred *api.CloudCredentialObject,
+	maxResults int64,
+	config interface{},
+) (map[string]*PluginClient, *string, error) {
+	var (
+		clients   map[string]*PluginClient
+		nextToken *string
+	)
+	if err := RunWithContext(ctx, func(context.Context) error {
+		var err error
+		clients, nextToken, err = a.plugin.GetAllClients(cloudCred, maxResults, config)
+		return err
+	}); err != nil {
+		return nil, nil, err
+	}
+	return clients, nextToken, nil
+}
+
+// RunWithContext runs fn with the context and returns the context error if
+// the context is done by the time fn returns, else the error returned by fn.
+// fn is run in the calling goroutine and always waited for, hence it should
+// honor the context to return early once it is done
+func RunWithContext(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	err := fn(ctx)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	return err
+}
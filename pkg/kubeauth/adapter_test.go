@@ -0,0 +1,106 @@
+package kubeauth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+)
+
+// cancellingPlugin cancels the context of the adapter while a lookup is
+// in progress
+type cancellingPlugin struct {
+	legacyPlugin
+	cancel context.CancelFunc
+}
+
+func (p *cancellingPlugin) GetClient(cloudCred *api.CloudCredentialObject, clusterName string, region string) (*PluginClient, error) {
+	p.cancel()
+	return p.legacyPlugin.GetClient(cloudCred, clusterName, region)
+}
+
+func (p *cancellingPlugin) GetAllClients(cloudCred *api.CloudCredentialObject, maxResults int64, config interface{}) (map[string]*PluginClient, *string, error) {
+	p.cancel()
+	return p.legacyPlugin.GetAllClients(cloudCred, maxResults, config)
+}
+
+func TestPluginAdapter(t *testing.T) {
+	calls := &[]string{}
+	adapter := NewPluginAdapter(&legacyPlugin{testPlugin{name: "p", calls: calls, updated: true}})
+	ctx := context.Background()
+
+	if updated, kubeconfig, err := adapter.UpdateClient(ctx, nil, "cred", "uid", "org", nil, nil); !updated || kubeconfig != "p" || err != nil {
+		t.Errorf("unexpected UpdateClient result %v, %v, %v", updated, kubeconfig, err)
+	}
+	if updated, kubeconfig, err := adapter.UpdateClientByCredObject(ctx, nil, nil, nil); !updated || kubeconfig != "p" || err != nil {
+		t.Errorf("unexpected UpdateClientByCredObject result %v, %v, %v", updated, kubeconfig, err)
+	}
+	if client, err := adapter.GetClient(ctx, nil, "c1", "r1"); err != nil || client.Uid != "p" {
+		t.Errorf("unexpected GetClient result %v, %v", client, err)
+	}
+	if clients, _, err := adapter.GetAllClients(ctx, nil, 0, nil); err != nil || clients["p"] == nil {
+		t.Errorf("unexpected GetAllClients result %v, %v", clients, err)
+	}
+	expected := "p.UpdateClient,p.UpdateClientByCredObject,p.GetClient,p.GetAllClients"
+	if actual := strings.Join(*calls, ","); actual != expected {
+		t.Errorf("expected calls %v, got %v", expected, actual)
+	}
+
+	// The plugin errors are returned as is
+	failure := errors.New("failure")
+	adapter = NewPluginAdapter(&legacyPlugin{testPlugin{name: "p", calls: calls, err: failure}})
+	if _, err := adapter.GetClient(ctx, nil, "c1", "r1"); err != failure {
+		t.Errorf("expected the plugin error, got %v", err)
+	}
+	if _, _, err := adapter.GetAllClients(ctx, nil, 0, nil); err != failure {
+		t.Errorf("expected the plugin error, got %v", err)
+	}
+}
+
+func TestPluginAdapterContextDone(t *testing.T) {
+	calls := &[]string{}
+	adapter := NewPluginAdapter(&legacyPlugin{testPlugin{name: "p", calls: calls, updated: true}})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The plugin is not called once the context is done
+	if _, _, err := adapter.UpdateClient(ctx, nil, "cred", "uid", "org", nil, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the context error from UpdateClient, got %v", err)
+	}
+	if _, _, err := adapter.UpdateClientByCredObject(ctx, nil, nil, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the context error from UpdateClientByCredObject, got %v", err)
+	}
+	if _, err := adapter.GetClient(ctx, nil, "c1", "r1"); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the context error from GetClient, got %v", err)
+	}
+	if _, _, err := adapter.GetAllClients(ctx, nil, 0, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the context error from GetAllClients, got %v", err)
+	}
+	if len(*calls) != 0 {
+		t.Errorf("expected no plugin calls, got %v", *calls)
+	}
+}
+
+func TestPluginAdapterContextDoneDuringCall(t *testing.T) {
+	calls := &[]string{}
+	plugin := &cancellingPlugin{legacyPlugin: legacyPlugin{testPlugin{name: "p", calls: calls}}}
+	adapter := NewPluginAdapter(plugin)
+
+	// The plugin call is waited for and its result dropped
+	ctx, cancel := context.WithCancel(context.Background())
+	plugin.cancel = cancel
+	if client, err := adapter.GetClient(ctx, nil, "c1", "r1"); client != nil || !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the context error from GetClient, got %v, %v", client, err)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	plugin.cancel = cancel
+	if clients, _, err := adapter.GetAllClients(ctx, nil, 0, nil); clients != nil || !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the context error from GetAllClients, got %v, %v", clients, err)
+	}
+	if len(*calls) != 2 {
+		t.Errorf("expected the plugin calls to complete, got %v", *calls)
+	}
+}
@@ -4,6 +4,11 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	api "github.com/portworx/px-backup-api/pkg/apis/v1"
 	"github.com/portworx/px-backup-api/pkg/kubeauth"
@@ -14,6 +19,9 @@ import (
 	"sigs.k8s.io/aws-iam-authenticator/pkg/token"
 
 	awsapi "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/eks"
 	awscredentials "github.com/libopenstorage/secrets/aws/credentials"
@@ -22,19 +30,54 @@ import (
 
 const (
 	pluginName = "aws"
+	// roleSessionName is the session name used for assuming roles
+	roleSessionName = "px-backup"
+	// maxConcurrentDescribe is the number of clusters described
+	// concurrently while scanning a region
+	maxConcurrentDescribe = 10
+	// defaultRegion is used for finding the partition of the regions
+	// scanned if no region is requested
+	defaultRegion = "us-east-1"
 )
 
 type aws struct {
 }
 
+var (
+	endpointLock sync.RWMutex
+	// eksEndpoint overrides the regional EKS endpoints when set
+	eksEndpoint string
+	// stsEndpoint overrides the STS endpoints used for assuming
+	// roles when set
+	stsEndpoint string
+)
+
+// SetEndpoint overrides the EKS endpoint used for all the regions. It is
+// meant for running the plugin against a fake EKS. An empty endpoint
+// restores the regional endpoints
+func SetEndpoint(endpoint string) {
+	endpointLock.Lock()
+	defer endpointLock.Unlock()
+	eksEndpoint = endpoint
+}
+
+// SetSTSEndpoint overrides the STS endpoint used for assuming the roles of
+// the cloud credentials. It is meant for running the plugin against a fake
+// STS. An empty endpoint restores the regional endpoints
+func SetSTSEndpoint(endpoint string) {
+	endpointLock.Lock()
+	defer endpointLock.Unlock()
+	stsEndpoint = endpoint
+}
+
 // Init initializes the gcp auth plugin
 func (a *aws) Init() error {
 	return nil
 }
 
 func (a *aws) UpdateClient(
-	conn *grpc.ClientConn,
 	ctx context.Context,
+	conn *grpc.ClientConn,
 	cloudCredentialName string,
 	cloudCredentialUID string,
 	orgID string,
@@ -63,7 +106,7 @@ func (a *aws) UpdateClient(
 				return false, emptyKubeconfig, err
 			}
 			cloudCredential := resp.GetCloudCredential()
-			if err := a.updateClient(cloudCredential, client); err != nil {
+			if err := a.updateClient(ctx, cloudCredential, client); err != nil {
 				return false, emptyKubeconfig, err
 			}
 			return true, emptyKubeconfig, nil
@@ -73,6 +116,7 @@ func (a *aws) UpdateClient(
 }
 
 func (a *aws) UpdateClientByCredObject(
+	ctx context.Context,
 	cloudCred *api.CloudCredentialObject,
 	client *rest.Config,
 	clientConfig *clientcmdapi.Config,
@@ -81,7 +125,7 @@ func (a *aws) UpdateClientByCredObject(
 	var emptyKubeconfig string
 	if client.ExecProvider != nil {
 		if client.ExecProvider.Command == "aws-iam-authenticator" || client.ExecProvider.Command == "aws" {
-			if err := a.updateClient(cloudCred, client); err != nil {
+			if err := a.updateClient(ctx, cloudCred, client); err != nil {
 				return false, emptyKubeconfig, err
 			}
 			return true, emptyKubeconfig, nil
@@ -94,6 +138,7 @@ func (a *aws) UpdateClientByCredObject(
 // updateClient assumes that the provided rest client is not nil
 // and has the aws exec provider field set
 func (a *aws) updateClient(
+	ctx context.Context,
 	cloudCredential *api.CloudCredentialObject,
 	client *rest.Config,
 ) error {
@@ -104,39 +149,119 @@ func (a *aws) updateClient(
 		return fmt.Errorf("need AWS CloudCredential for EKS cluster. Provided %v", cloudCredential.GetCloudCredentialInfo().GetType())
 	}
 
-	if client.ExecProvider.Env == nil {
-		client.ExecProvider.Env = make([]clientcmdapi.ExecEnvVar, 0)
+	awsConfig := cloudCredential.GetCloudCredentialInfo().GetAwsConfig()
+	if awsConfig.GetRoleArn() != "" {
+		// The credentials of the assumed role expire, so they cannot be
+		// passed to the exec plugin. Generate the tokens in process with
+		// the role credentials refreshed by the AWS SDK instead
+		if clusterID, region := getExecCluster(client.ExecProvider); clusterID != "" {
+			return updateRoleClient(ctx, awsConfig, client, clusterID, region)
+		}
+		// Else let the exec plugin assume the role with the source
+		// credentials
+		if awsConfig.GetExternalId() != "" {
+			return fmt.Errorf("cluster name not found in the exec args of the EKS cluster, the exec plugin cannot assume a role with an external ID")
+		}
+		if client.ExecProvider.Command == "aws" {
+			client.ExecProvider.Args = kubeauth.SetExecArg(client.ExecProvider.Args, "--role-arn", "", awsConfig.GetRoleArn())
+		} else {
+			client.ExecProvider.Args = kubeauth.SetExecArg(client.ExecProvider.Args, "--role", "-r", awsConfig.GetRoleArn())
+		}
+	}
+	credsValue := credentials.Value{
+		AccessKeyID:     awsConfig.GetAccessKey(),
+		SecretAccessKey: awsConfig.GetSecretKey(),
+		SessionToken:    awsConfig.GetSessionToken(),
 	}
-	client.ExecProvider.Env = append(client.ExecProvider.Env, clientcmdapi.ExecEnvVar{
-		Name:  "AWS_ACCESS_KEY",
-		Value: cloudCredential.GetCloudCredentialInfo().GetAwsConfig().GetAccessKey(),
-	})
-	client.ExecProvider.Env = append(client.ExecProvider.Env, clientcmdapi.ExecEnvVar{
-		Name:  "AWS_ACCESS_KEY_ID",
-		Value: cloudCredential.GetCloudCredentialInfo().GetAwsConfig().GetAccessKey(),
-	})
-	client.ExecProvider.Env = append(client.ExecProvider.Env, clientcmdapi.ExecEnvVar{
-		Name:  "AWS_SECRET_KEY",
-		Value: cloudCredential.GetCloudCredentialInfo().GetAwsConfig().GetSecretKey(),
-	})
-	client.ExecProvider.Env = append(client.ExecProvider.Env, clientcmdapi.ExecEnvVar{
-		Name:  "AWS_SECRET_ACCESS_KEY",
-		Value: cloudCredential.GetCloudCredentialInfo().GetAwsConfig().GetSecretKey(),
-	})
 
-	// Remove the profile env if present since we are passing in the creds through env
+	// Remove the profile env if present since we are passing in the creds through env.
+	// Also remove the creds from any previous update
+	credEnvs := []clientcmdapi.ExecEnvVar{
+		{Name: "AWS_ACCESS_KEY", Value: credsValue.AccessKeyID},
+		{Name: "AWS_ACCESS_KEY_ID", Value: credsValue.AccessKeyID},
+		{Name: "AWS_SECRET_KEY", Value: credsValue.SecretAccessKey},
+		{Name: "AWS_SECRET_ACCESS_KEY", Value: credsValue.SecretAccessKey},
+	}
+	if credsValue.SessionToken != "" {
+		credEnvs = append(credEnvs, clientcmdapi.ExecEnvVar{
+			Name:  "AWS_SESSION_TOKEN",
+			Value: credsValue.SessionToken,
+		})
+	}
 	tempEnv := make([]clientcmdapi.ExecEnvVar, 0)
 	for _, env := range client.ExecProvider.Env {
-		if env.Name == "AWS_PROFILE" {
+		if env.Name == "AWS_PROFILE" || env.Name == "AWS_SESSION_TOKEN" {
+			continue
+		}
+		stale := false
+		for _, credEnv := range credEnvs {
+			if env.Name == credEnv.Name {
+				stale = true
+				break
+			}
+		}
+		if stale {
 			continue
 		}
 		tempEnv = append(tempEnv, env)
 	}
-	client.ExecProvider.Env = tempEnv
+	client.ExecProvider.Env = append(tempEnv, credEnvs...)
 	return nil
 }
 
+// updateRoleClient replaces the exec plugin of the client with the cached
+// tokens of the cluster generated with the assumed role
+func updateRoleClient(
+	ctx context.Context,
+	awsConfig *api.AWSConfig,
+	client *rest.Config,
+	clusterID string,
+	region string,
+) error {
+	if region == "" {
+		region = defaultRegion
+	}
+	creds, err := getCredentials(ctx, awsConfig, region)
+	if err != nil {
+		return err
+	}
+	sess, err := session.NewSession(&awsapi.Config{
+		Region:      awsapi.String(region),
+		Credentials: creds,
+	})
+	if err != nil {
+		return err
+	}
+	tokenSource, err := getTokenSource(ctx, client.Host, clusterID, sess, awsConfig)
+	if err != nil {
+		return err
+	}
+	client.ExecProvider = nil
+	client.Wrap(tokenSource.WrapTransport())
+	return nil
+}
+
+// getExecCluster returns the cluster name and the region passed to the
+// "aws eks get-token" or "aws-iam-authenticator token" exec plugins
+func getExecCluster(exec *clientcmdapi.ExecConfig) (string, string) {
+	clusterID := kubeauth.ExecArg(exec.Args, "--cluster-name", "")
+	if clusterID == "" {
+		clusterID = kubeauth.ExecArg(exec.Args, "--cluster-id", "-i")
+	}
+	region := kubeauth.ExecArg(exec.Args, "--region", "")
+	// AWS_REGION takes precedence over AWS_DEFAULT_REGION
+	for _, name := range []string{"AWS_REGION", "AWS_DEFAULT_REGION"} {
+		for _, env := range exec.Env {
+			if region == "" && env.Name == name {
+				region = env.Value
+			}
+		}
+	}
+	return clusterID, region
+}
+
 func (a *aws) GetClient(
+	ctx context.Context,
 	cloudCredential *api.CloudCredentialObject,
 	clusterName string,
 	region string,
@@ -145,11 +270,12 @@ func (a *aws) GetClient(
 	if awsConfig == nil {
 		return nil, fmt.Errorf("cloud credentials are not for aws")
 	}
-	return GetRestConfigForCluster(clusterName, awsConfig, region)
+	return GetRestConfigForCluster(ctx, clusterName, awsConfig, region)
 
 }
 
 func (a *aws) GetAllClients(
+	ctx context.Context,
 	cloudCredential *api.CloudCredentialObject,
 	maxResults int64,
 	config interface{},
@@ -158,11 +284,11 @@ func (a *aws) GetAllClients(
 	if awsConfig == nil {
 		return nil, nil, fmt.Errorf("cloud credentials are not for aws")
 	}
-	return GetRestConfigForAllClusters(awsConfig, maxResults, config)
+	return GetRestConfigForAllClusters(ctx, awsConfig, maxResults, config)
 
 }
 
-func runningOnEc2() bool {
+func runningOnEc2(ctx context.Context) bool {
 	// Check if we are running on EC2 instance
 	var runningOnEc2 bool
 	s, err := session.NewSession()
@@ -170,24 +296,15 @@ func runningOnEc2() bool {
 		return runningOnEc2
 	}
 	c := ec2metadata.New(s)
-	_, err = c.GetMetadata("mac")
+	_, err = c.GetMetadataWithContext(ctx, "mac")
 	if err == nil {
 		runningOnEc2 = true
 	}
 	return runningOnEc2
 }
 
-func GetRestConfigForCluster(clusterName string, awsConfig *api.AWSConfig, region string) (*kubeauth.PluginClient, error) {
-	awsCreds, err := awscredentials.NewAWSCredentials(
-		awsConfig.GetAccessKey(),
-		awsConfig.GetSecretKey(),
-		"",
-		runningOnEc2(),
-	)
-	if err != nil {
-		return nil, err
-	}
-	creds, err := awsCreds.Get()
+func GetRestConfigForCluster(ctx context.Context, clusterName string, awsConfig *api.AWSConfig, region string) (*kubeauth.PluginClient, error) {
+	creds, err := getCredentials(ctx, awsConfig, region)
 	if err != nil {
 		return nil, err
 	}
@@ -195,16 +312,16 @@ func GetRestConfigForCluster(clusterName string, awsConfig *api.AWSConfig, regio
 		Region:      awsapi.String(region),
 		Credentials: creds,
 	}))
-	eksSvc := eks.New(sess)
+	eksSvc := newEKSClient(sess)
 
-	describeClusterOutput, err := eksSvc.DescribeCluster(&eks.DescribeClusterInput{
+	describeClusterOutput, err := eksSvc.DescribeClusterWithContext(ctx, &eks.DescribeClusterInput{
 		Name: &clusterName,
 	})
 	if err != nil {
 		logrus.Errorf("Failed to describe cluster %v: %v", clusterName, err)
 		return nil, err
 	}
-	restConfig, kubeConfig, err := getRestConfig(describeClusterOutput.Cluster, sess)
+	restConfig, kubeConfig, err := getRestConfig(ctx, describeClusterOutput.Cluster, sess, awsConfig)
 	if err != nil {
 		logrus.Infof("Failed to create a clientset for cluster %v: %v", clusterName, err)
 		return nil, err
@@ -213,88 +330,300 @@ func GetRestConfigForCluster(clusterName string, awsConfig *api.AWSConfig, regio
 		Kubeconfig: kubeConfig,
 		Rest:       restConfig, 
 		Uid:        clusterName, // aws does not have uid
+		Version:    awsapi.StringValue(describeClusterOutput.Cluster.Version),
+		Region:     region,
 	}, nil
 }
 
-//func GetRestConfigForAllClusters(awsConfig *api.AWSConfig, region string) (map[string]*kubeauth.PluginClient, error) {
+// GetRestConfigForAllClusters returns the clients for all the EKS clusters in
+// the requested regions. The clusters which could not be accessed, and the
+// regions which could not be scanned, are reported through
+// kubeauth.ReportSkippedCluster. When scanning multiple regions the clients
+// are keyed by "<region>/<cluster-name>" and the next token is of the form
+// "<region-index>:<aws-next-token>"
 func GetRestConfigForAllClusters(
+	ctx context.Context,
 	awsConfig *api.AWSConfig,
 	maxResults int64,
 	config interface{},
 ) (map[string]*kubeauth.PluginClient, *string, error) {
-	funct := "GetRestConfigForAllClusters"
-	awsCfg := config.(*api.ManagedClusterEnumerateRequest_AWSConfig)
-	awsCreds, err := awscredentials.NewAWSCredentials(
-		awsConfig.GetAccessKey(),
-		awsConfig.GetSecretKey(),
-		"",
-		runningOnEc2(),
-	)
+	awsCfg, ok := config.(*api.ManagedClusterEnumerateRequest_AWSConfig)
+	if !ok || awsCfg == nil {
+		return nil, nil, fmt.Errorf("invalid config %T provided for EKS cluster scan", config)
+	}
+	if !awsCfg.AllRegions && len(awsCfg.Regions) == 0 {
+		return scanRegion(ctx, awsConfig, awsCfg.Region, maxResults, awsCfg.NextToken, false)
+	}
+
+	regions := awsCfg.Regions
+	if awsCfg.AllRegions {
+		var err error
+		if regions, err = getRegions(awsCfg.Region); err != nil {
+			return nil, nil, err
+		}
+	}
+	regionIdx, regionToken, err := parseRegionToken(awsCfg.NextToken, len(regions))
 	if err != nil {
 		return nil, nil, err
 	}
-	creds, err := awsCreds.Get()
+	restConfigs := make(map[string]*kubeauth.PluginClient)
+	for ; regionIdx < len(regions); regionIdx, regionToken = regionIdx+1, "" {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		var remaining int64
+		if maxResults != 0 {
+			remaining = maxResults - int64(len(restConfigs))
+			if remaining <= 0 {
+				token := fmt.Sprintf("%d:", regionIdx)
+				return restConfigs, &token, nil
+			}
+		}
+		regionConfigs, nextToken, err := scanRegion(ctx, awsConfig, regions[regionIdx], remaining, regionToken, true)
+		if err != nil {
+			// Regions can be disabled through SCPs or IAM policies, or
+			// not be opted in, don't stop the scan for one region error
+			kubeauth.ReportSkippedCluster(ctx, regions[regionIdx], &kubeauth.SkippedCluster{
+				Region: regions[regionIdx],
+				Reason: fmt.Sprintf("failed to scan region: %v", err),
+			})
+			continue
+		}
+		for name, restConfig := range regionConfigs {
+			restConfigs[name] = restConfig
+		}
+		if nextToken != nil {
+			token := fmt.Sprintf("%d:%s", regionIdx, awsapi.StringValue(nextToken))
+			return restConfigs, &token, nil
+		}
+	}
+	return restConfigs, nil, nil
+}
+
+// scanRegion lists the EKS clusters in the region and describes them
+// and generates their tokens concurrently
+func scanRegion(
+	ctx context.Context,
+	awsConfig *api.AWSConfig,
+	region string,
+	maxResults int64,
+	nextToken string,
+	keyByRegion bool,
+) (map[string]*kubeauth.PluginClient, *string, error) {
+	funct := "scanRegion"
+	creds, err := getCredentials(ctx, awsConfig, region)
 	if err != nil {
 		return nil, nil, err
 	}
 	sess := session.Must(session.NewSession(&awsapi.Config{
-		Region:      awsapi.String(awsCfg.Region),
+		Region:      awsapi.String(region),
 		Credentials: creds,
 	}))
-	eksSvc := eks.New(sess)
+	eksSvc := newEKSClient(sess)
 	listClustersInput := eks.ListClustersInput{}
 	if maxResults != 0 {
 		listClustersInput.MaxResults = &maxResults
 	}
-	
-	if len(awsCfg.NextToken) != 0 {
-		listClustersInput.NextToken = &awsCfg.NextToken
+
+	if len(nextToken) != 0 {
+		listClustersInput.NextToken = &nextToken
 	}
 
-	listClusterOutput, err := eksSvc.ListClusters(&listClustersInput)
+	listClusterOutput, err := eksSvc.ListClustersWithContext(ctx, &listClustersInput)
 	if err != nil {
 		return nil, nil, err
 	}
 	logrus.Tracef("%s: listClusterOutput: %v", funct, listClusterOutput)
+
+	var (
+		wg   sync.WaitGroup
+		lock sync.Mutex
+		sem  = make(chan struct{}, maxConcurrentDescribe)
+	)
 	restConfigs := make(map[string]*kubeauth.PluginClient)
 	for _, clusterName := range listClusterOutput.Clusters {
-		describeClusterOutput, err := eksSvc.DescribeCluster(&eks.DescribeClusterInput{
-			Name: clusterName,
-		})
-		if err != nil {
-			logrus.Errorf("Failed to describe cluster %v: %v", *clusterName, err)
-			continue
+		name := awsapi.StringValue(clusterName)
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			key := name
+			if keyByRegion {
+				key = region + "/" + name
+			}
+			pluginClient, skipped := describeCluster(ctx, eksSvc, sess, awsConfig, name)
+			if skipped != nil {
+				skipped.Region = region
+				kubeauth.ReportSkippedCluster(ctx, key, skipped)
+				return
+			}
+			pluginClient.Region = region
+			lock.Lock()
+			restConfigs[key] = pluginClient
+			lock.Unlock()
+		}()
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	return restConfigs, listClusterOutput.NextToken, nil
+}
+
+// describeCluster returns the client for the cluster. On error it returns
+// the skipped cluster instead as we don't want to stop the scan for one
+// cluster error. Error could be genuine where IAM user doesn't have
+// permission to access a cluster.
+func describeCluster(
+	ctx context.Context,
+	eksSvc *eks.EKS,
+	sess *session.Session,
+	awsConfig *api.AWSConfig,
+	clusterName string,
+) (*kubeauth.PluginClient, *kubeauth.SkippedCluster) {
+	describeClusterOutput, err := eksSvc.DescribeClusterWithContext(ctx, &eks.DescribeClusterInput{
+		Name: &clusterName,
+	})
+	if err != nil {
+		return nil, &kubeauth.SkippedCluster{
+			Uid:    clusterName, // aws does not have uid
+			Reason: fmt.Sprintf("failed to describe cluster: %v", err),
 		}
-		restConfig, kubeConfig, err := getRestConfig(describeClusterOutput.Cluster, sess)
-		// On error continue to next cluster as we don't want to stop the 
-		// scan for one cluster error.
-		// Error could be genuine where IAM user doesn't have permission to access
-		// a cluster.
-		if err != nil {
-			logrus.Infof("skipping cluster %v",  awsapi.StringValue(clusterName))
-			continue
+	}
+	version := awsapi.StringValue(describeClusterOutput.Cluster.Version)
+	if status := awsapi.StringValue(describeClusterOutput.Cluster.Status); status != eks.ClusterStatusActive {
+		return nil, &kubeauth.SkippedCluster{
+			Uid:     clusterName,
+			Version: version,
+			Reason:  fmt.Sprintf("cluster is in %v status", status),
 		}
-		restConfigs[awsapi.StringValue(clusterName)] = &kubeauth.PluginClient{
-			Kubeconfig: kubeConfig,
-			Rest:       restConfig,
-			Uid:        awsapi.StringValue(clusterName), // aws does not have uid
-			Version:    awsapi.StringValue(describeClusterOutput.Cluster.Version),
+	}
+	restConfig, kubeConfig, err := getRestConfig(ctx, describeClusterOutput.Cluster, sess, awsConfig)
+	if err != nil {
+		return nil, &kubeauth.SkippedCluster{
+			Uid:     clusterName,
+			Version: version,
+			Reason:  fmt.Sprintf("failed to generate token: %v", err),
 		}
 	}
-	return restConfigs, listClusterOutput.NextToken, nil
+	return &kubeauth.PluginClient{
+		Kubeconfig: kubeConfig,
+		Rest:       restConfig,
+		Uid:        clusterName, // aws does not have uid
+		Version:    version,
+	}, nil
+}
 
+// getRegions returns the regions of the partition of the given region in
+// which EKS is available. The regions which are not enabled for the account
+// fail to be scanned and are reported as skipped
+func getRegions(region string) ([]string, error) {
+	if region == "" {
+		region = defaultRegion
+	}
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+	if !ok {
+		return nil, fmt.Errorf("unknown region %v", region)
+	}
+	service, ok := partition.Services()[eks.EndpointsID]
+	if !ok {
+		return nil, fmt.Errorf("EKS is not available in partition %v", partition.ID())
+	}
+	regions := make([]string, 0)
+	for name := range service.Regions() {
+		regions = append(regions, name)
+	}
+	// Keep the order stable across pages
+	sort.Strings(regions)
+	return regions, nil
 }
 
-func getRestConfig(cluster *eks.Cluster, sess *session.Session) (*rest.Config, string, error) {
-	gen, err := token.NewGenerator(true, false)
+func parseRegionToken(token string, numRegions int) (int, string, error) {
+	if token == "" {
+		return 0, "", nil
+	}
+	tokens := strings.SplitN(token, ":", 2)
+	if len(tokens) != 2 {
+		return 0, "", fmt.Errorf("invalid next token %v provided for EKS cluster scan", token)
+	}
+	regionIdx, err := strconv.Atoi(tokens[0])
+	if err != nil || regionIdx < 0 || regionIdx >= numRegions {
+		return 0, "", fmt.Errorf("invalid next token %v provided for EKS cluster scan", token)
+	}
+	return regionIdx, tokens[1], nil
+}
+
+// getCredentials returns the credentials from the cloud credential. If a role
+// is provided, it returns the credentials of the assumed role which are
+// refreshed by the AWS SDK when they expire
+func getCredentials(ctx context.Context, awsConfig *api.AWSConfig, region string) (*credentials.Credentials, error) {
+	awsCreds, err := awscredentials.NewAWSCredentials(
+		awsConfig.GetAccessKey(),
+		awsConfig.GetSecretKey(),
+		awsConfig.GetSessionToken(),
+		// The instance role is only used if the keys are not provided
+		awsConfig.GetAccessKey() == "" && runningOnEc2(ctx),
+	)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
-	opts := &token.GetTokenOptions{
-		ClusterID: awsapi.StringValue(cluster.Name),
-		Session:   sess,
+	creds, err := awsCreds.Get()
+	if err != nil {
+		return nil, err
+	}
+	if awsConfig.GetRoleArn() == "" {
+		return creds, nil
+	}
+	stsConfig := &awsapi.Config{
+		Credentials: creds,
 	}
-	tok, err := gen.GetWithOptions(opts)
+	if region != "" {
+		// Use the regional STS endpoint of the clusters
+		stsConfig.Region = awsapi.String(region)
+		stsConfig.STSRegionalEndpoint = endpoints.RegionalSTSEndpoint
+	}
+	endpointLock.RLock()
+	if stsEndpoint != "" {
+		stsConfig.Endpoint = awsapi.String(stsEndpoint)
+		if stsConfig.Region == nil {
+			stsConfig.Region = awsapi.String(defaultRegion)
+		}
+	}
+	endpointLock.RUnlock()
+	sess, err := session.NewSession(stsConfig)
+	if err != nil {
+		return nil, err
+	}
+	roleCreds := stscreds.NewCredentials(sess, awsConfig.GetRoleArn(), func(p *stscreds.AssumeRoleProvider) {
+		p.RoleSessionName = roleSessionName
+		if awsConfig.GetExternalId() != "" {
+			p.ExternalID = awsapi.String(awsConfig.GetExternalId())
+		}
+		if awsConfig.GetSessionDuration() != 0 {
+			p.Duration = time.Duration(awsConfig.GetSessionDuration()) * time.Second
+		}
+	})
+	// Assume the role upfront to report an error for invalid roles
+	if _, err := roleCreds.GetWithContext(ctx); err != nil {
+		return nil, fmt.Errorf("failed to assume role %v: %v", awsConfig.GetRoleArn(), err)
+	}
+	return roleCreds, nil
+}
+
+func newEKSClient(sess *session.Session) *eks.EKS {
+	endpointLock.RLock()
+	defer endpointLock.RUnlock()
+	if eksEndpoint == "" {
+		return eks.New(sess)
+	}
+	return eks.New(sess, &awsapi.Config{Endpoint: awsapi.String(eksEndpoint)})
+}
+
+func getRestConfig(ctx context.Context, cluster *eks.Cluster, sess *session.Session, awsConfig *api.AWSConfig) (*rest.Config, string, error) {
+	tokenSource, err := getTokenSource(ctx, awsapi.StringValue(cluster.Arn), awsapi.StringValue(cluster.Name), sess, awsConfig)
 	if err != nil {
 		return nil, "", err
 	}
@@ -305,15 +634,11 @@ func getRestConfig(cluster *eks.Cluster, sess *session.Session) (*rest.Config, s
 	}
 
 	restConfig := &rest.Config{
-		Host:        awsapi.StringValue(cluster.Endpoint),
-		BearerToken: tok.Token,
+		Host: awsapi.StringValue(cluster.Endpoint),
 		TLSClientConfig: rest.TLSClientConfig{
 			CAData: ca,
 		},
-	}
-
-	if err != nil {
-		return nil, "", err
+		WrapTransport: tokenSource.WrapTransport(),
 	}
 
 	// Copy cert data as is kubeconfig
@@ -321,6 +646,52 @@ func getRestConfig(cluster *eks.Cluster, sess *session.Session) (*rest.Config, s
 	return restConfig, buildKubeconfig(awsapi.StringValue(cluster.Endpoint), awsapi.StringValue(cluster.Name), caData), nil
 }
 
+// getTokenSource returns the cached token source of the cluster. It
+// generates the first token upfront to validate the access to the cluster
+func getTokenSource(
+	ctx context.Context,
+	clusterKey string,
+	clusterID string,
+	sess *session.Session,
+	awsConfig *api.AWSConfig,
+) (*kubeauth.CachedTokenSource, error) {
+	gen, err := token.NewGenerator(true, false)
+	if err != nil {
+		return nil, err
+	}
+	opts := &token.GetTokenOptions{
+		ClusterID: clusterID,
+		Session:   sess,
+	}
+	// The STS presigned tokens expire in 15 minutes. Cache them per cluster
+	// and identity, and regenerate them before they expire. The identity is
+	// the static access key along with the assumed role if any, as the
+	// access keys of the assumed role change on every refresh. Different
+	// source credentials assuming the same role get their own token source
+	tokenSource := kubeauth.GetCachedTokenSource(
+		fmt.Sprintf("%s/%s/%s/%s", pluginName, clusterKey, awsConfig.GetAccessKey(), awsConfig.GetRoleArn()),
+		kubeauth.TokenFingerprint(
+			awsConfig.GetAccessKey(),
+			awsConfig.GetSecretKey(),
+			awsConfig.GetSessionToken(),
+			awsConfig.GetRoleArn(),
+			awsConfig.GetExternalId(),
+			strconv.FormatInt(awsConfig.GetSessionDuration(), 10),
+		),
+		func(context.Context) (string, time.Time, error) {
+			tok, err := gen.GetWithOptions(opts)
+			if err != nil {
+				return "", time.Time{}, err
+			}
+			return tok.Token, tok.Expiration, nil
+		},
+	)
+	if _, err := tokenSource.Token(ctx); err != nil {
+		return nil, err
+	}
+	return tokenSource, nil
+}
+
 // the kubeconfig spec taken from - https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html#create-kubeconfig-manually
 func buildKubeconfig(
 	clusterEndpoint string,
@@ -356,7 +727,19 @@ users:
 }
 
 func init() {
-	if err := kubeauth.Register(pluginName, &aws{}); err != nil {
+	descriptor := &kubeauth.Descriptor{
+		CredentialType: api.CloudCredentialInfo_AWS,
+		Matcher: kubeauth.Matcher{
+			ExecCommands: []string{"aws-iam-authenticator", "aws"},
+		},
+		AllowedExecCommands: []string{"aws-iam-authenticator token", "aws eks get-token"},
+		Capabilities: kubeauth.Capabilities{
+			KubeconfigRefresh: true,
+			SingleLookup:      true,
+			Enumeration:       true,
+		},
+	}
+	if err := kubeauth.RegisterPlugin(pluginName, &aws{}, descriptor); err != nil {
 		logrus.Panicf("Error registering aws auth plugin: %v", err)
 	}
 }
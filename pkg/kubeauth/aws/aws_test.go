@@ -0,0 +1,209 @@
+package aws_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+	"github.com/portworx/px-backup-api/pkg/kubeauth"
+	"github.com/portworx/px-backup-api/pkg/kubeauth/aws"
+	"github.com/portworx/px-backup-api/pkg/kubeauth/fake"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	testRoleArn    = "arn:aws:iam::123456789012:role/px-backup"
+	testExternalID = "px-backup-external-id"
+)
+
+func newFakes(t *testing.T, clusters ...*fake.Cluster) (*fake.EKS, *fake.STS) {
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+	eks := fake.NewEKS(clusters...)
+	sts := fake.NewSTS(map[string]string{testRoleArn: testExternalID})
+	aws.SetEndpoint(eks.URL())
+	aws.SetSTSEndpoint(sts.URL())
+	t.Cleanup(func() {
+		aws.SetEndpoint("")
+		aws.SetSTSEndpoint("")
+		eks.Close()
+		sts.Close()
+	})
+	return eks, sts
+}
+
+func newCloudCredential(awsConfig *api.AWSConfig) *api.CloudCredentialObject {
+	if awsConfig.AccessKey == "" {
+		awsConfig.AccessKey = "AKIAFAKE00000001"
+		awsConfig.SecretKey = "fake-secret"
+	}
+	return fake.NewCloudCredential(awsConfig)
+}
+
+func TestGetAllClients(t *testing.T) {
+	server := fake.NewAPIServer("v1.29.1")
+	defer server.Close()
+	newFakes(t,
+		&fake.Cluster{Name: "active", Region: "us-east-1", Version: "1.29", Server: server},
+		&fake.Cluster{Name: "creating", Region: "us-east-1", Version: "1.29", Status: "CREATING"},
+		&fake.Cluster{Name: "other-region", Region: "us-west-2", Version: "1.28"},
+	)
+
+	clients, skipped, nextToken, err := kubeauth.GetAllClientsWithSkipped(
+		context.Background(),
+		newCloudCredential(&api.AWSConfig{}),
+		0,
+		&api.ManagedClusterEnumerateRequest_AWSConfig{Region: "us-east-1"},
+	)
+	if err != nil {
+		t.Fatalf("GetAllClientsWithSkipped failed: %v", err)
+	}
+	if nextToken != nil {
+		t.Errorf("unexpected next token %v", *nextToken)
+	}
+	if len(clients) != 1 || clients["active"] == nil {
+		t.Fatalf("expected only the active cluster, got %v", clients)
+	}
+	client := clients["active"]
+	if client.Version != "1.29" || client.Region != "us-east-1" {
+		t.Errorf("unexpected client %+v", client)
+	}
+	if skipped["creating"] == nil || !strings.Contains(skipped["creating"].Reason, "CREATING") {
+		t.Errorf("expected the creating cluster to be skipped, got %v", skipped)
+	}
+	fake.CheckClient(t, client.Rest, server)
+	if token := server.LastToken(); !strings.HasPrefix(token, "k8s-aws-v1.") {
+		t.Errorf("expected an EKS token, got %q", token)
+	}
+}
+
+func TestGetAllClientsMultiRegion(t *testing.T) {
+	newFakes(t,
+		&fake.Cluster{Name: "c1", Region: "us-east-1"},
+		&fake.Cluster{Name: "c1", Region: "us-west-2"},
+		&fake.Cluster{Name: "c2", Region: "us-west-2"},
+	)
+	cloudCred := newCloudCredential(&api.AWSConfig{})
+	config := &api.ManagedClusterEnumerateRequest_AWSConfig{Regions: []string{"us-east-1", "us-west-2"}}
+
+	pages := fake.AllClients(t, cloudCred, 2, config)
+	// The next tokens carry the index of the region being scanned
+	if len(pages) != 2 || !strings.HasPrefix(pages[0].NextToken, "1:") {
+		t.Errorf("expected the second page to continue in the second region, got %v pages", len(pages))
+	}
+	clients, _ := fake.Merge(pages)
+	for _, key := range []string{"us-east-1/c1", "us-west-2/c1", "us-west-2/c2"} {
+		if clients[key] == nil {
+			t.Errorf("missing client for %v in %v", key, clients)
+		}
+	}
+	if len(clients) != 3 {
+		t.Errorf("unexpected clients %v", clients)
+	}
+}
+
+func TestGetClientWithRole(t *testing.T) {
+	_, sts := newFakes(t, &fake.Cluster{Name: "c1", Region: "us-east-1", Version: "1.29"})
+
+	client, err := kubeauth.GetClientWithContext(
+		context.Background(),
+		newCloudCredential(&api.AWSConfig{RoleArn: testRoleArn, ExternalId: testExternalID}),
+		"c1",
+		"us-east-1",
+	)
+	if err != nil {
+		t.Fatalf("GetClientWithContext failed: %v", err)
+	}
+	if client.Version != "1.29" {
+		t.Errorf("unexpected version %v", client.Version)
+	}
+	if sts.AssumedRoles() == 0 {
+		t.Errorf("expected the role to be assumed")
+	}
+
+	_, err = kubeauth.GetClientWithContext(
+		context.Background(),
+		newCloudCredential(&api.AWSConfig{RoleArn: testRoleArn, ExternalId: "wrong"}),
+		"c1",
+		"us-east-1",
+	)
+	if err == nil || !strings.Contains(err.Error(), "failed to assume role") {
+		t.Errorf("expected the role assumption to fail, got %v", err)
+	}
+}
+
+func TestUpdateClientWithRole(t *testing.T) {
+	server := fake.NewAPIServer("v1.29.1")
+	defer server.Close()
+	_, sts := newFakes(t)
+	cloudCred := newCloudCredential(&api.AWSConfig{RoleArn: testRoleArn, ExternalId: testExternalID})
+
+	// The tokens are generated in process with the assumed role
+	restConfig := &rest.Config{
+		Host:            server.URL,
+		TLSClientConfig: rest.TLSClientConfig{CAData: server.CAData()},
+		ExecProvider: &clientcmdapi.ExecConfig{
+			Command: "aws",
+			Args:    []string{"eks", "get-token", "--cluster-name", "c1", "--region", "us-east-1"},
+		},
+	}
+	if _, err := kubeauth.UpdateClientByCredObjectWithContext(context.Background(), cloudCred, restConfig, nil); err != nil {
+		t.Fatalf("UpdateClientByCredObjectWithContext failed: %v", err)
+	}
+	if restConfig.ExecProvider != nil || restConfig.WrapTransport == nil {
+		t.Fatalf("expected the exec plugin to be replaced, got %+v", restConfig)
+	}
+	if sts.AssumedRoles() == 0 {
+		t.Errorf("expected the role to be assumed")
+	}
+	fake.CheckClient(t, restConfig, server)
+	if token := server.LastToken(); !strings.HasPrefix(token, "k8s-aws-v1.") {
+		t.Errorf("expected an EKS token, got %q", token)
+	}
+
+	// Without the cluster name the exec plugin assumes the role with the
+	// source credentials
+	cloudCred = newCloudCredential(&api.AWSConfig{RoleArn: testRoleArn})
+	restConfig = &rest.Config{
+		Host: server.URL,
+		ExecProvider: &clientcmdapi.ExecConfig{
+			Command: "aws-iam-authenticator",
+			Args:    []string{"token", "-r", "arn:aws:iam::123456789012:role/other"},
+			Env:     []clientcmdapi.ExecEnvVar{{Name: "AWS_SESSION_TOKEN", Value: "expired"}},
+		},
+	}
+	if _, err := kubeauth.UpdateClientByCredObjectWithContext(context.Background(), cloudCred, restConfig, nil); err != nil {
+		t.Fatalf("UpdateClientByCredObjectWithContext failed: %v", err)
+	}
+	args := strings.Join(restConfig.ExecProvider.Args, " ")
+	if args != "token --role "+testRoleArn {
+		t.Errorf("unexpected args %q", args)
+	}
+	for _, env := range restConfig.ExecProvider.Env {
+		if env.Name == "AWS_SESSION_TOKEN" || (env.Name == "AWS_ACCESS_KEY_ID" && env.Value != "AKIAFAKE00000001") {
+			t.Errorf("unexpected env %v=%v", env.Name, env.Value)
+		}
+	}
+
+	// The exec plugin cannot pass the external ID
+	cloudCred = newCloudCredential(&api.AWSConfig{RoleArn: testRoleArn, ExternalId: testExternalID})
+	restConfig.ExecProvider.Args = []string{"token"}
+	if _, err := kubeauth.UpdateClientByCredObjectWithContext(context.Background(), cloudCred, restConfig, nil); err == nil {
+		t.Errorf("expected the update to fail for the external ID")
+	}
+}
+
+func TestGetAllClientsInvalidConfig(t *testing.T) {
+	newFakes(t)
+	for _, config := range []interface{}{
+		nil,
+		(*api.ManagedClusterEnumerateRequest_AWSConfig)(nil),
+		&api.ManagedClusterEnumerateRequest_GoogleConfig{},
+	} {
+		_, _, _, err := kubeauth.GetAllClientsWithSkipped(context.Background(), newCloudCredential(&api.AWSConfig{}), 0, config)
+		if err == nil || !strings.Contains(err.Error(), "invalid config") {
+			t.Errorf("expected an invalid config error for %T, got %v", config, err)
+		}
+	}
+}
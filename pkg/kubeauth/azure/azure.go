@@ -0,0 +1,566 @@
+package azure
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+	"github.com/portworx/px-backup-api/pkg/kubeauth"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	"google.golang.org/grpc"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	pluginName = "azure"
+	// kubeloginCommand is the exec credential plugin used by AKS clusters
+	// with Azure AD integration
+	kubeloginCommand = "kubelogin"
+	// aksServerID is the well known application ID of the AKS AAD server
+	aksServerID          = "6dae42f8-4368-4678-94ff-3960e28e3630"
+	defaultARMEndpoint   = "https://management.azure.com"
+	defaultLoginEndpoint = "https://login.microsoftonline.com"
+	armScope             = defaultARMEndpoint + "/.default"
+	aksAPIVersion        = "2023-08-01"
+	managedClustersType  = "Microsoft.ContainerService/managedClusters"
+	spnLoginMode         = "spn"
+	loginArg             = "--login"
+	loginShortArg        = "-l"
+	clientIDArg          = "--client-id"
+	tenantIDArg          = "--tenant-id"
+	serverIDArg          = "--server-id"
+	oidcIssuerURLArg     = "--oidc-issuer-url"
+	spnClientIDEnv       = "AAD_SERVICE_PRINCIPAL_CLIENT_ID"
+	spnClientSecretEnv   = "AAD_SERVICE_PRINCIPAL_CLIENT_SECRET"
+	azureClientIDEnv     = "AZURE_CLIENT_ID"
+	azureClientSecretEnv = "AZURE_CLIENT_SECRET"
+	azureTenantIDEnv     = "AZURE_TENANT_ID"
+)
+
+var (
+	endpointLock sync.RWMutex
+	// armEndpoint is the Azure Resource Manager endpoint
+	armEndpoint = defaultARMEndpoint
+	// loginEndpoint is the Azure AD endpoint the tokens are fetched from
+	loginEndpoint = defaultLoginEndpoint
+)
+
+type azure struct {
+}
+
+// SetEndpoints overrides the Azure Resource Manager and Azure AD login
+// endpoints. It is meant for running the plugin against a fake Azure.
+// Empty endpoints restore the default endpoints
+func SetEndpoints(arm string, login string) {
+	endpointLock.Lock()
+	defer endpointLock.Unlock()
+	if arm == "" {
+		arm = defaultARMEndpoint
+	}
+	if login == "" {
+		login = defaultLoginEndpoint
+	}
+	armEndpoint = strings.TrimSuffix(arm, "/")
+	loginEndpoint = strings.TrimSuffix(login, "/")
+}
+
+func getEndpoints() (string, string) {
+	endpointLock.RLock()
+	defer endpointLock.RUnlock()
+	return armEndpoint, loginEndpoint
+}
+
+// managedCluster is the subset of the ARM ManagedCluster resource
+// used by this plugin
+type managedCluster struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Location   string `json:"location"`
+	Properties struct {
+		KubernetesVersion        string `json:"kubernetesVersion"`
+		CurrentKubernetesVersion string `json:"currentKubernetesVersion"`
+	} `json:"properties"`
+}
+
+type managedClusterList struct {
+	Value    []managedCluster `json:"value"`
+	NextLink string           `json:"nextLink"`
+}
+
+type credentialResults struct {
+	Kubeconfigs []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"kubeconfigs"`
+}
+
+// Init initializes the azure auth plugin
+func (a *azure) Init() error {
+	return nil
+}
+
+func (a *azure) UpdateClient(
+	ctx context.Context,
+	conn *grpc.ClientConn,
+	cloudCredentialName string,
+	cloudCredentialUID string,
+	orgID string,
+	client *rest.Config,
+	clientConfig *clientcmdapi.Config,
+) (bool, string, error) {
+	// Azure does not support returning kubeconfigs
+	var emptyKubeconfig string
+	if isAKSProvider(client) {
+		if cloudCredentialName == "" {
+			return false, emptyKubeconfig, fmt.Errorf("CloudCredential not provided for AKS cluster")
+		}
+		cloudCredentialClient := api.NewCloudCredentialClient(conn)
+		resp, err := cloudCredentialClient.Inspect(
+			ctx,
+			&api.CloudCredentialInspectRequest{
+				Name:           cloudCredentialName,
+				Uid:            cloudCredentialUID,
+				OrgId:          orgID,
+				IncludeSecrets: true,
+			},
+		)
+		if err != nil {
+			return false, emptyKubeconfig, err
+		}
+		if err := a.updateClient(resp.GetCloudCredential(), client); err != nil {
+			return false, emptyKubeconfig, err
+		}
+		return true, emptyKubeconfig, nil
+	} // else not an azure kubeauth provider
+	return false, emptyKubeconfig, nil
+}
+
+func (a *azure) UpdateClientByCredObject(
+	_ context.Context,
+	cloudCred *api.CloudCredentialObject,
+	client *rest.Config,
+	clientConfig *clientcmdapi.Config,
+) (bool, string, error) {
+	// Azure does not support returning kubeconfigs
+	var emptyKubeconfig string
+	if isAKSProvider(client) {
+		if err := a.updateClient(cloudCred, client); err != nil {
+			return false, emptyKubeconfig, err
+		}
+		return true, emptyKubeconfig, nil
+	} // else not an azure kubeauth provider
+	return false, emptyKubeconfig, nil
+}
+
+// updateClient assumes that the provided rest client is not nil
+// and has the kubelogin exec provider field set. It switches the
+// kubelogin login mode to service principal and passes the
+// credentials through the environment
+func (a *azure) updateClient(
+	cloudCredential *api.CloudCredentialObject,
+	client *rest.Config,
+) error {
+	if cloudCredential == nil {
+		return fmt.Errorf("CloudCredential not provided for AKS cluster")
+	}
+	if cloudCredential.GetCloudCredentialInfo().GetType() != api.CloudCredentialInfo_Azure {
+		return fmt.Errorf("need Azure CloudCredential for AKS cluster. Provided %v", cloudCredential.GetCloudCredentialInfo().GetType())
+	}
+	azureConfig := cloudCredential.GetCloudCredentialInfo().GetAzureConfig()
+	if azureConfig.GetClientId() == "" || azureConfig.GetClientSecret() == "" || azureConfig.GetTenantId() == "" {
+		return fmt.Errorf("client_id, client_secret and tenant_id are required in the Azure CloudCredential for AKS cluster")
+	}
+
+	args := kubeauth.SetExecArg(client.ExecProvider.Args, loginArg, loginShortArg, spnLoginMode)
+	args = kubeauth.SetExecArg(args, clientIDArg, "", azureConfig.GetClientId())
+	args = kubeauth.SetExecArg(args, tenantIDArg, "", azureConfig.GetTenantId())
+	client.ExecProvider.Args = args
+
+	// Remove any stale credentials before passing in the creds through env
+	credEnvs := map[string]string{
+		spnClientIDEnv:       azureConfig.GetClientId(),
+		spnClientSecretEnv:   azureConfig.GetClientSecret(),
+		azureClientIDEnv:     azureConfig.GetClientId(),
+		azureClientSecretEnv: azureConfig.GetClientSecret(),
+		azureTenantIDEnv:     azureConfig.GetTenantId(),
+	}
+	tempEnv := make([]clientcmdapi.ExecEnvVar, 0)
+	for _, env := range client.ExecProvider.Env {
+		if _, exists := credEnvs[env.Name]; exists {
+			continue
+		}
+		tempEnv = append(tempEnv, env)
+	}
+	for _, name := range []string{spnClientIDEnv, spnClientSecretEnv, azureClientIDEnv, azureClientSecretEnv, azureTenantIDEnv} {
+		tempEnv = append(tempEnv, clientcmdapi.ExecEnvVar{
+			Name:  name,
+			Value: credEnvs[name],
+		})
+	}
+	client.ExecProvider.Env = tempEnv
+	return nil
+}
+
+func (a *azure) GetClient(
+	ctx context.Context,
+	cloudCredential *api.CloudCredentialObject,
+	clusterName string,
+	region string,
+) (*kubeauth.PluginClient, error) {
+	azureConfig := cloudCredential.GetCloudCredentialInfo().GetAzureConfig()
+	if azureConfig == nil {
+		return nil, fmt.Errorf("cloud credentials are not for azure")
+	}
+	return GetRestConfigForCluster(ctx, clusterName, azureConfig, region)
+}
+
+func (a *azure) GetAllClients(
+	ctx context.Context,
+	cloudCredential *api.CloudCredentialObject,
+	maxResults int64,
+	config interface{},
+) (map[string]*kubeauth.PluginClient, *string, error) {
+	azureConfig := cloudCredential.GetCloudCredentialInfo().GetAzureConfig()
+	if azureConfig == nil {
+		return nil, nil, fmt.Errorf("cloud credentials are not for azure")
+	}
+	return GetRestConfigForAllClusters(ctx, azureConfig, maxResults, config)
+}
+
+// GetRestConfigForCluster returns the client for the AKS cluster with the
+// given name. The cluster name can optionally be prefixed with its resource
+// group as "<resource-group>/<cluster-name>", which is required if clusters
+// with the same name exist in several resource groups. If region is
+// provided only a cluster in that location is matched
+func GetRestConfigForCluster(ctx context.Context, clusterName string, azureConfig *api.AzureConfig, region string) (*kubeauth.PluginClient, error) {
+	httpClient, err := armClient(ctx, azureConfig)
+	if err != nil {
+		return nil, err
+	}
+	var resourceGroup string
+	if tokens := strings.SplitN(clusterName, "/", 2); len(tokens) == 2 {
+		resourceGroup, clusterName = tokens[0], tokens[1]
+	}
+	listURL := managedClustersURL(azureConfig.GetSubscriptionId(), resourceGroup)
+	matches := make([]managedCluster, 0)
+	for listURL != "" {
+		clusters, err := listManagedClusters(ctx, httpClient, listURL)
+		if err != nil {
+			return nil, err
+		}
+		for _, cluster := range clusters.Value {
+			if cluster.Name != clusterName {
+				continue
+			}
+			if region != "" && !strings.EqualFold(cluster.Location, region) {
+				continue
+			}
+			matches = append(matches, cluster)
+		}
+		listURL = clusters.NextLink
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("AKS cluster %v not found in subscription %v", clusterName, azureConfig.GetSubscriptionId())
+	case 1:
+		return getPluginClient(ctx, httpClient, azureConfig, &matches[0])
+	}
+	keys := make([]string, 0, len(matches))
+	for idx := range matches {
+		keys = append(keys, clusterKey(&matches[idx]))
+	}
+	return nil, fmt.Errorf("AKS cluster name %v is ambiguous, it matches %v. Provide it as <resource-group>/<cluster-name>",
+		clusterName, strings.Join(keys, ", "))
+}
+
+// GetRestConfigForAllClusters returns the clients for all the AKS clusters
+// in the subscription, or in the resource group if one is provided, which
+// the cloud credential has access to. The clients are keyed by
+// "<resource-group>/<cluster-name>" as cluster names are only unique within
+// a resource group. ARM decides the page size, hence maxResults is not
+// honored and the returned token is the ARM nextLink
+func GetRestConfigForAllClusters(
+	ctx context.Context,
+	azureConfig *api.AzureConfig,
+	maxResults int64,
+	config interface{},
+) (map[string]*kubeauth.PluginClient, *string, error) {
+	funct := "GetRestConfigForAllClusters"
+	azureCfg, ok := config.(*api.ManagedClusterEnumerateRequest_AzureConfig)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid config %T provided for AKS cluster scan", config)
+	}
+	httpClient, err := armClient(ctx, azureConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	listURL := azureCfg.GetNextToken()
+	if listURL == "" {
+		listURL = managedClustersURL(azureConfig.GetSubscriptionId(), azureCfg.GetResourceGroup())
+	} else if arm, _ := getEndpoints(); !strings.HasPrefix(listURL, arm+"/") {
+		// Do not send the credentials to anything but ARM
+		return nil, nil, fmt.Errorf("invalid next token provided for AKS cluster scan")
+	}
+	clusters, err := listManagedClusters(ctx, httpClient, listURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	logrus.Tracef("%s: listed %v clusters", funct, len(clusters.Value))
+	restConfigs := make(map[string]*kubeauth.PluginClient)
+	for idx := range clusters.Value {
+		cluster := &clusters.Value[idx]
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		pluginClient, err := getPluginClient(ctx, httpClient, azureConfig, cluster)
+		// On error continue to next cluster as we don't want to stop the
+		// scan for one cluster error.
+		// Error could be genuine where the service principal doesn't have
+		// permission to fetch the credentials of a cluster.
+		if err != nil {
+			version := cluster.Properties.CurrentKubernetesVersion
+			if version == "" {
+				version = cluster.Properties.KubernetesVersion
+			}
+			kubeauth.ReportSkippedCluster(ctx, clusterKey(cluster), &kubeauth.SkippedCluster{
+				Uid:     cluster.ID,
+				Version: version,
+				Region:  cluster.Location,
+				Reason:  err.Error(),
+			})
+			continue
+		}
+		restConfigs[clusterKey(cluster)] = pluginClient
+	}
+	var nextToken *string
+	if clusters.NextLink != "" {
+		nextToken = &clusters.NextLink
+	}
+	return restConfigs, nextToken, nil
+}
+
+func getPluginClient(
+	ctx context.Context,
+	httpClient *http.Client,
+	azureConfig *api.AzureConfig,
+	cluster *managedCluster,
+) (*kubeauth.PluginClient, error) {
+	kubeconfigBytes, err := getClusterUserKubeconfig(ctx, httpClient, cluster.ID)
+	if err != nil {
+		return nil, err
+	}
+	restConfig, kubeconfig, err := getRestConfig(kubeconfigBytes, azureConfig)
+	if err != nil {
+		return nil, err
+	}
+	version := cluster.Properties.CurrentKubernetesVersion
+	if version == "" {
+		version = cluster.Properties.KubernetesVersion
+	}
+	return &kubeauth.PluginClient{
+		Kubeconfig: kubeconfig,
+		Rest:       restConfig,
+		Uid:        cluster.ID,
+		Version:    version,
+	}, nil
+}
+
+// getRestConfig builds the rest config from the kubeconfig returned by AKS.
+// For Azure AD enabled clusters the kubelogin exec provider is switched to
+// service principal login in the returned kubeconfig, while the rest config
+// fetches the AAD tokens in-process so that kubelogin is not required
+func getRestConfig(kubeconfig []byte, azureConfig *api.AzureConfig) (*rest.Config, string, error) {
+	rawConfig, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, "", err
+	}
+	var serverID string
+	for _, authInfo := range rawConfig.AuthInfos {
+		if authInfo.Exec == nil || !isKubelogin(authInfo.Exec.Command) {
+			continue
+		}
+		serverID = kubeauth.ExecArg(authInfo.Exec.Args, serverIDArg, "")
+		if serverID == "" {
+			serverID = aksServerID
+		}
+		args := kubeauth.SetExecArg(authInfo.Exec.Args, loginArg, loginShortArg, spnLoginMode)
+		args = kubeauth.SetExecArg(args, clientIDArg, "", azureConfig.GetClientId())
+		authInfo.Exec.Args = kubeauth.SetExecArg(args, tenantIDArg, "", azureConfig.GetTenantId())
+	}
+	kubeconfigBytes, err := clientcmd.Write(*rawConfig)
+	if err != nil {
+		return nil, "", err
+	}
+	defClient := clientcmd.NewDefaultClientConfig(*rawConfig, &clientcmd.ConfigOverrides{})
+	restConfig, err := defClient.ClientConfig()
+	if err != nil {
+		return nil, "", err
+	}
+	if serverID != "" {
+		// The rest config outlives the request, so its tokens are not
+		// bound to the context of the request
+		scope := serverID + "/.default"
+		tokenSource := kubeauth.GetCachedTokenSource(
+			strings.Join([]string{pluginName, azureConfig.GetTenantId(), azureConfig.GetClientId(), scope}, "/"),
+			kubeauth.TokenFingerprint(azureConfig.GetClientSecret()),
+			kubeauth.OAuth2TokenGenerator(tokenSource(context.Background(), azureConfig, scope)),
+		)
+		restConfig.ExecProvider = nil
+		restConfig.WrapTransport = tokenSource.WrapTransport()
+	}
+	return restConfig, string(kubeconfigBytes), nil
+}
+
+func getClusterUserKubeconfig(ctx context.Context, httpClient *http.Client, clusterID string) ([]byte, error) {
+	arm, _ := getEndpoints()
+	credURL := fmt.Sprintf("%s%s/listClusterUserCredential?api-version=%s&format=exec", arm, clusterID, aksAPIVersion)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, credURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := readResponse(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credentials for cluster %v: %v", clusterID, err)
+	}
+	creds := &credentialResults{}
+	if err := json.Unmarshal(body, creds); err != nil {
+		return nil, err
+	}
+	if len(creds.Kubeconfigs) == 0 {
+		return nil, fmt.Errorf("no kubeconfig returned for cluster %v", clusterID)
+	}
+	return base64.StdEncoding.DecodeString(creds.Kubeconfigs[0].Value)
+}
+
+func listManagedClusters(ctx context.Context, httpClient *http.Client, listURL string) (*managedClusterList, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, listURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := readResponse(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list AKS clusters: %v", err)
+	}
+	clusters := &managedClusterList{}
+	if err := json.Unmarshal(body, clusters); err != nil {
+		return nil, err
+	}
+	return clusters, nil
+}
+
+// clusterKey returns "<resource-group>/<cluster-name>" for the cluster, or
+// its name if the resource group cannot be found in its ARM ID
+func clusterKey(cluster *managedCluster) string {
+	if resourceGroup := resourceGroupOf(cluster.ID); resourceGroup != "" {
+		return resourceGroup + "/" + cluster.Name
+	}
+	return cluster.Name
+}
+
+// resourceGroupOf returns the resource group of the ARM ID, which has the
+// form /subscriptions/<id>/resourceGroups/<resource-group>/providers/...
+func resourceGroupOf(armID string) string {
+	tokens := strings.Split(armID, "/")
+	for idx := 0; idx+1 < len(tokens); idx++ {
+		if strings.EqualFold(tokens[idx], "resourceGroups") {
+			return tokens[idx+1]
+		}
+	}
+	return ""
+}
+
+func readResponse(resp *http.Response) ([]byte, error) {
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%v: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+func managedClustersURL(subscriptionID string, resourceGroup string) string {
+	scope := "/subscriptions/" + url.PathEscape(subscriptionID)
+	if resourceGroup != "" {
+		scope += "/resourceGroups/" + url.PathEscape(resourceGroup)
+	}
+	arm, _ := getEndpoints()
+	return fmt.Sprintf("%s%s/providers/%s?api-version=%s", arm, scope, managedClustersType, aksAPIVersion)
+}
+
+// armClient returns the client for the ARM requests. Its tokens are fetched
+// with the provided context, hence it should not be used once the context
+// is done
+func armClient(ctx context.Context, azureConfig *api.AzureConfig) (*http.Client, error) {
+	if azureConfig.GetSubscriptionId() == "" {
+		return nil, fmt.Errorf("subscription_id is required in the Azure CloudCredential for scanning AKS clusters")
+	}
+	if azureConfig.GetClientId() == "" || azureConfig.GetClientSecret() == "" || azureConfig.GetTenantId() == "" {
+		return nil, fmt.Errorf("client_id, client_secret and tenant_id are required in the Azure CloudCredential for scanning AKS clusters")
+	}
+	return oauth2.NewClient(ctx, tokenSource(ctx, azureConfig, armScope)), nil
+}
+
+// tokenSource returns the source of the AAD tokens for the scope. The
+// tokens are fetched with the provided context
+func tokenSource(ctx context.Context, azureConfig *api.AzureConfig, scope string) oauth2.TokenSource {
+	_, login := getEndpoints()
+	cfg := &clientcredentials.Config{
+		ClientID:     azureConfig.GetClientId(),
+		ClientSecret: azureConfig.GetClientSecret(),
+		TokenURL:     login + "/" + url.PathEscape(azureConfig.GetTenantId()) + "/oauth2/v2.0/token",
+		Scopes:       []string{scope},
+	}
+	return cfg.TokenSource(ctx)
+}
+
+func isAKSProvider(client *rest.Config) bool {
+	// The oidc-login plugin can also be installed as kubelogin. Those
+	// configs carry the issuer and are handled by the oidc plugin
+	return client.ExecProvider != nil && isKubelogin(client.ExecProvider.Command) &&
+		kubeauth.ExecArg(client.ExecProvider.Args, oidcIssuerURLArg, "") == ""
+}
+
+func isKubelogin(command string) bool {
+	return command == kubeloginCommand || strings.HasSuffix(command, "/"+kubeloginCommand)
+}
+
+func init() {
+	descriptor := &kubeauth.Descriptor{
+		CredentialType: api.CloudCredentialInfo_Azure,
+		Matcher: kubeauth.Matcher{
+			ExecCommands: []string{kubeloginCommand},
+		},
+		AllowedExecCommands: []string{kubeloginCommand + " get-token"},
+		Capabilities: kubeauth.Capabilities{
+			KubeconfigRefresh: true,
+			SingleLookup:      true,
+			Enumeration:       true,
+		},
+	}
+	if err := kubeauth.RegisterPlugin(pluginName, &azure{}, descriptor); err != nil {
+		logrus.Panicf("Error registering azure auth plugin: %v", err)
+	}
+}
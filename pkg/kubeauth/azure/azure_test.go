@@ -0,0 +1,119 @@
+package azure_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+	"github.com/portworx/px-backup-api/pkg/kubeauth"
+	"github.com/portworx/px-backup-api/pkg/kubeauth/azure"
+	"github.com/portworx/px-backup-api/pkg/kubeauth/fake"
+)
+
+var testCredential = fake.AzureCredential{
+	TenantID:       "tenant",
+	ClientID:       "client",
+	ClientSecret:   "secret",
+	SubscriptionID: "subscription",
+}
+
+func newFake(t *testing.T, clusters ...*fake.Cluster) *fake.Azure {
+	cloud := fake.NewAzure(testCredential, clusters...)
+	azure.SetEndpoints(cloud.URL(), cloud.URL())
+	t.Cleanup(func() {
+		azure.SetEndpoints("", "")
+		cloud.Close()
+	})
+	return cloud
+}
+
+func newCloudCredential(clientSecret string) *api.CloudCredentialObject {
+	return fake.NewCloudCredential(&api.AzureConfig{
+		TenantId:       testCredential.TenantID,
+		ClientId:       testCredential.ClientID,
+		ClientSecret:   clientSecret,
+		SubscriptionId: testCredential.SubscriptionID,
+	})
+}
+
+func TestGetAllClients(t *testing.T) {
+	server := fake.NewAPIServer("v1.29.2")
+	defer server.Close()
+	cloud := newFake(t,
+		&fake.Cluster{Name: "c1", Region: "eastus", ResourceGroup: "rg1", Version: "1.29.2", Server: server},
+		&fake.Cluster{Name: "c1", Region: "westeurope", ResourceGroup: "rg2", Version: "1.28.5"},
+		&fake.Cluster{Name: "c2", Region: "eastus", ResourceGroup: "rg2", Version: "1.28.5"},
+	)
+	cloud.SetPageSize(2)
+	cloudCred := newCloudCredential(testCredential.ClientSecret)
+	config := &api.ManagedClusterEnumerateRequest_AzureConfig{}
+
+	// The pages of ARM are followed through its next links
+	pages := fake.AllClients(t, cloudCred, 0, config)
+	if len(pages) != 2 || !strings.HasPrefix(pages[0].NextToken, cloud.URL()) {
+		t.Errorf("expected 2 pages linked through ARM, got %v", len(pages))
+	}
+	clients, _ := fake.Merge(pages)
+	for _, key := range []string{"rg1/c1", "rg2/c1", "rg2/c2"} {
+		if clients[key] == nil {
+			t.Errorf("missing client for %v in %v", key, clients)
+		}
+	}
+	client := clients["rg1/c1"]
+	if client == nil {
+		t.FailNow()
+	}
+	if client.Uid != cloud.ClusterID(&fake.Cluster{Name: "c1", ResourceGroup: "rg1"}) || client.Version != "1.29.2" {
+		t.Errorf("unexpected client %+v", client)
+	}
+	// The kubeconfig uses the service principal login instead of the
+	// device code login returned by AKS
+	if !strings.Contains(client.Kubeconfig, "spn") || strings.Contains(client.Kubeconfig, "devicecode") {
+		t.Errorf("expected the kubeconfig to use the service principal login:\n%v", client.Kubeconfig)
+	}
+	fake.CheckClient(t, client.Rest, server)
+	if server.LastToken() == "" {
+		t.Errorf("expected the request to carry an AAD token")
+	}
+
+	config = &api.ManagedClusterEnumerateRequest_AzureConfig{NextToken: "https://attacker.example.com/list"}
+	if _, _, _, err := kubeauth.GetAllClientsWithSkipped(context.Background(), cloudCred, 0, config); err == nil {
+		t.Errorf("expected a next token outside of ARM to be rejected")
+	}
+}
+
+func TestGetClient(t *testing.T) {
+	newFake(t,
+		&fake.Cluster{Name: "c1", Region: "eastus", ResourceGroup: "rg1", Version: "1.29.2"},
+		&fake.Cluster{Name: "c1", Region: "westeurope", ResourceGroup: "rg2", Version: "1.28.5"},
+	)
+	cloudCred := newCloudCredential(testCredential.ClientSecret)
+
+	_, err := kubeauth.GetClientWithContext(context.Background(), cloudCred, "c1", "")
+	if err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("expected an ambiguity error, got %v", err)
+	}
+	for _, test := range []struct {
+		name    string
+		region  string
+		version string
+	}{
+		{name: "rg2/c1", version: "1.28.5"},
+		{name: "c1", region: "eastus", version: "1.29.2"},
+	} {
+		client, err := kubeauth.GetClientWithContext(context.Background(), cloudCred, test.name, test.region)
+		if err != nil {
+			t.Errorf("GetClientWithContext(%v, %v) failed: %v", test.name, test.region, err)
+			continue
+		}
+		if client.Version != test.version {
+			t.Errorf("GetClientWithContext(%v, %v) returned version %v, expected %v",
+				test.name, test.region, client.Version, test.version)
+		}
+	}
+
+	if _, err := kubeauth.GetClientWithContext(context.Background(), newCloudCredential("wrong"), "rg1/c1", ""); err == nil {
+		t.Errorf("expected an invalid client secret to fail")
+	}
+}
@@ -0,0 +1,479 @@
+package kubeauth
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+	"golang.org/x/net/proxy"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	storkGroup        = "stork.libopenstorage.org"
+	storkGroupVersion = storkGroup + "/v1alpha1"
+)
+
+// ResourceAccess is an access to a resource verified through a
+// SelfSubjectAccessReview
+type ResourceAccess struct {
+	Verb     string
+	Group    string
+	Resource string
+}
+
+func (r ResourceAccess) String() string {
+	if r.Group == "" {
+		return r.Verb + " " + r.Resource
+	}
+	return r.Verb + " " + r.Resource + "." + r.Group
+}
+
+var (
+	// StorkAccess is the cluster wide access needed by Stork for backing
+	// up and restoring the applications
+	StorkAccess = []ResourceAccess{
+		{Verb: "list", Resource: "namespaces"},
+		{Verb: "create", Resource: "namespaces"},
+		{Verb: "create", Resource: "secrets"},
+		{Verb: "list", Group: "apiextensions.k8s.io", Resource: "customresourcedefinitions"},
+		{Verb: "create", Group: storkGroup, Resource: "applicationbackups"},
+		{Verb: "list", Group: storkGroup, Resource: "applicationbackups"},
+		{Verb: "delete", Group: storkGroup, Resource: "applicationbackups"},
+		{Verb: "create", Group: storkGroup, Resource: "applicationrestores"},
+		{Verb: "list", Group: storkGroup, Resource: "applicationrestores"},
+		{Verb: "delete", Group: storkGroup, Resource: "applicationrestores"},
+		{Verb: "create", Group: storkGroup, Resource: "backuplocations"},
+		{Verb: "delete", Group: storkGroup, Resource: "backuplocations"},
+	}
+	// StorkResources are the Stork CRDs which need to be installed
+	StorkResources = []string{
+		"applicationbackups",
+		"applicationrestores",
+		"applicationbackupschedules",
+		"backuplocations",
+	}
+)
+
+// Diagnose runs the staged access checks against the cluster of the rest
+// config and returns the result of every check. The checks of a stage are
+// skipped once a check of a prior stage has failed. The checks are bound
+// to the provided context. The server is reached through the proxy of the
+// rest config, or of the environment, as the clients of the rest config do
+func Diagnose(ctx context.Context, restConfig *rest.Config) []*api.ClusterDiagnosticCheck {
+	d := &diagnostics{
+		checks: make([]*api.ClusterDiagnosticCheck, 0),
+	}
+	config := ConfigWithContext(ctx, restConfig)
+	u, err := serverURL(config)
+	if err != nil {
+		d.add(api.ClusterDiagnosticCheck_Reachability, "parse server url", err)
+		d.skip(api.ClusterDiagnosticCheck_TLS, api.ClusterDiagnosticCheck_StorkCRDs)
+		return d.checks
+	}
+
+	proxyFunc := proxyFuncFor(config)
+	proxyURL, err := proxyFunc(&http.Request{Method: http.MethodGet, URL: u, Header: make(http.Header)})
+	if err != nil {
+		d.add(api.ClusterDiagnosticCheck_Reachability, "get proxy", err)
+		d.skip(api.ClusterDiagnosticCheck_TLS, api.ClusterDiagnosticCheck_StorkCRDs)
+		return d.checks
+	}
+	d.checkReachability(ctx, u, proxyURL)
+	if d.failed {
+		d.skip(api.ClusterDiagnosticCheck_TLS, api.ClusterDiagnosticCheck_StorkCRDs)
+		return d.checks
+	}
+	d.checkTLS(ctx, config, u, proxyFunc)
+	if d.failed {
+		d.skip(api.ClusterDiagnosticCheck_Authentication, api.ClusterDiagnosticCheck_StorkCRDs)
+		return d.checks
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		d.add(api.ClusterDiagnosticCheck_Authentication, "create client", err)
+		d.skip(api.ClusterDiagnosticCheck_Authorization, api.ClusterDiagnosticCheck_StorkCRDs)
+		return d.checks
+	}
+	d.checkAuthentication(clientset)
+	if d.failed {
+		d.skip(api.ClusterDiagnosticCheck_Authorization, api.ClusterDiagnosticCheck_StorkCRDs)
+		return d.checks
+	}
+	d.checkAuthorization(ctx, clientset)
+	if d.failed {
+		d.skip(api.ClusterDiagnosticCheck_StorkCRDs, api.ClusterDiagnosticCheck_StorkCRDs)
+		return d.checks
+	}
+	d.checkStorkCRDs(clientset)
+	return d.checks
+}
+
+// DiagnosticsReason returns a summary of the failed checks to be set as the
+// reason of the cluster status. It reports the failed checks of the first
+// stage which failed and names the stages which were skipped because of
+// it. It is empty if none of the checks failed
+func DiagnosticsReason(checks []*api.ClusterDiagnosticCheck) string {
+	var failedStage api.ClusterDiagnosticCheck_Stage
+	reasons := make([]string, 0)
+	skipped := make([]string, 0)
+	for _, check := range checks {
+		switch check.GetStatus().GetStatus() {
+		case api.ClusterDiagnosticCheck_StatusInfo_Failed:
+			if len(reasons) == 0 {
+				failedStage = check.GetStage()
+			}
+			if check.GetStage() == failedStage {
+				reasons = append(reasons, fmt.Sprintf("check %q failed: %v", check.GetName(), check.GetStatus().GetReason()))
+			}
+		case api.ClusterDiagnosticCheck_StatusInfo_Skipped:
+			skipped = append(skipped, check.GetStage().String())
+		}
+	}
+	if len(reasons) == 0 {
+		return ""
+	}
+	reason := fmt.Sprintf("%v stage failed: %v", failedStage, strings.Join(reasons, "; "))
+	if len(skipped) > 0 {
+		reason += fmt.Sprintf("; skipped stages: %v", strings.Join(skipped, ", "))
+	}
+	return reason
+}
+
+type diagnostics struct {
+	checks []*api.ClusterDiagnosticCheck
+	// failed is set once a check has failed
+	failed bool
+}
+
+// add adds a check which passed if err is nil, else failed
+func (d *diagnostics) add(stage api.ClusterDiagnosticCheck_Stage, name string, err error) {
+	if err != nil {
+		d.failed = true
+		d.addStatus(stage, name, api.ClusterDiagnosticCheck_StatusInfo_Failed, err.Error())
+		return
+	}
+	d.addStatus(stage, name, api.ClusterDiagnosticCheck_StatusInfo_Passed, "")
+}
+
+func (d *diagnostics) addStatus(
+	stage api.ClusterDiagnosticCheck_Stage,
+	name string,
+	status api.ClusterDiagnosticCheck_StatusInfo_Status,
+	reason string,
+) {
+	d.checks = append(d.checks, &api.ClusterDiagnosticCheck{
+		Stage: stage,
+		Name:  name,
+		Status: &api.ClusterDiagnosticCheck_StatusInfo{
+			Status: status,
+			Reason: reason,
+		},
+	})
+}
+
+// skip adds a skipped check, named after its stage, for each of the
+// stages from first to last
+func (d *diagnostics) skip(first api.ClusterDiagnosticCheck_Stage, last api.ClusterDiagnosticCheck_Stage) {
+	for stage := first; stage <= last; stage++ {
+		d.addStatus(stage, stage.String(), api.ClusterDiagnosticCheck_StatusInfo_Skipped, "a check of a prior stage failed")
+	}
+}
+
+// checkReachability resolves and connects to the server. When a proxy is
+// used the proxy is resolved and connected to instead, and the connection
+// to the HTTPS servers is tunneled through the proxy, as the server is
+// resolved by the proxy
+func (d *diagnostics) checkReachability(ctx context.Context, u *url.URL, proxyURL *url.URL) {
+	target := u
+	if proxyURL != nil {
+		target = proxyURL
+	}
+	host := target.Hostname()
+	if net.ParseIP(host) == nil {
+		if _, err := net.DefaultResolver.LookupHost(ctx, host); err != nil {
+			d.add(api.ClusterDiagnosticCheck_Reachability, "resolve "+host, err)
+			return
+		}
+		d.add(api.ClusterDiagnosticCheck_Reachability, "resolve "+host, nil)
+	}
+	if proxyURL == nil {
+		dialer := &net.Dialer{}
+		conn, err := dialer.DialContext(ctx, "tcp", hostPort(u))
+		if err == nil {
+			conn.Close()
+		}
+		d.add(api.ClusterDiagnosticCheck_Reachability, "connect "+hostPort(u), err)
+		return
+	}
+	if u.Scheme != "https" {
+		// The plain HTTP requests are forwarded by the proxy, not tunneled
+		dialer := &net.Dialer{}
+		conn, err := dialer.DialContext(ctx, "tcp", hostPort(proxyURL))
+		if err == nil {
+			conn.Close()
+		}
+		d.add(api.ClusterDiagnosticCheck_Reachability, "connect proxy "+hostPort(proxyURL), err)
+		return
+	}
+	conn, err := dialThroughProxy(ctx, proxyURL, hostPort(u))
+	if err == nil {
+		conn.Close()
+	}
+	d.add(api.ClusterDiagnosticCheck_Reachability,
+		fmt.Sprintf("connect %v through proxy %v", hostPort(u), hostPort(proxyURL)), err)
+}
+
+// checkTLS verifies the server certificate through an HTTP request made
+// with the TLS config and the proxy of the rest config, but without its
+// credentials. Any response of the server means that the certificate was
+// accepted
+func (d *diagnostics) checkTLS(
+	ctx context.Context,
+	config *rest.Config,
+	u *url.URL,
+	proxyFunc func(*http.Request) (*url.URL, error),
+) {
+	const name = "verify server certificate"
+	if u.Scheme != "https" {
+		d.addStatus(api.ClusterDiagnosticCheck_TLS, name, api.ClusterDiagnosticCheck_StatusInfo_Warning,
+			"the API server is accessed without TLS")
+		return
+	}
+	tlsConfig, err := rest.TLSConfigFor(config)
+	if err != nil {
+		d.add(api.ClusterDiagnosticCheck_TLS, name, err)
+		return
+	}
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+	}
+	tlsConfig = tlsConfig.Clone()
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = u.Hostname()
+	}
+	transport := &http.Transport{
+		Proxy:           proxyFunc,
+		TLSClientConfig: tlsConfig,
+	}
+	defer transport.CloseIdleConnections()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(u.String(), "/")+"/version", nil)
+	if err != nil {
+		d.add(api.ClusterDiagnosticCheck_TLS, name, err)
+		return
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		d.add(api.ClusterDiagnosticCheck_TLS, name, tlsError(err))
+		return
+	}
+	resp.Body.Close()
+	if tlsConfig.InsecureSkipVerify {
+		d.addStatus(api.ClusterDiagnosticCheck_TLS, name, api.ClusterDiagnosticCheck_StatusInfo_Warning,
+			"the server certificate is not verified as insecure-skip-tls-verify is set")
+		return
+	}
+	d.add(api.ClusterDiagnosticCheck_TLS, name, nil)
+}
+
+func (d *diagnostics) checkAuthentication(clientset kubernetes.Interface) {
+	version, err := clientset.Discovery().ServerVersion()
+	if err != nil {
+		d.add(api.ClusterDiagnosticCheck_Authentication, "get server version", err)
+		return
+	}
+	d.add(api.ClusterDiagnosticCheck_Authentication, "get server version", nil)
+	// The version is usually readable anonymously, so check an endpoint
+	// which is only readable once authenticated
+	const name = "get server groups"
+	_, err = clientset.Discovery().ServerGroups()
+	switch {
+	case k8serrors.IsUnauthorized(err):
+		d.add(api.ClusterDiagnosticCheck_Authentication, name, fmt.Errorf("the credentials were rejected by the API server %v: %v", version.GitVersion, err))
+	case k8serrors.IsForbidden(err):
+		d.add(api.ClusterDiagnosticCheck_Authentication, name, fmt.Errorf("the request was handled as anonymous by the API server %v: %v", version.GitVersion, err))
+	default:
+		d.add(api.ClusterDiagnosticCheck_Authentication, name, err)
+	}
+}
+
+func (d *diagnostics) checkAuthorization(ctx context.Context, clientset kubernetes.Interface) {
+	for _, access := range StorkAccess {
+		review := &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Verb:     access.Verb,
+					Group:    access.Group,
+					Resource: access.Resource,
+				},
+			},
+		}
+		resp, err := clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+		if err != nil {
+			err = fmt.Errorf("failed to create SelfSubjectAccessReview: %v", err)
+		} else if !resp.Status.Allowed {
+			err = fmt.Errorf("access denied")
+			if resp.Status.Reason != "" {
+				err = fmt.Errorf("access denied: %v", resp.Status.Reason)
+			}
+		}
+		d.add(api.ClusterDiagnosticCheck_Authorization, access.String(), err)
+	}
+}
+
+func (d *diagnostics) checkStorkCRDs(clientset kubernetes.Interface) {
+	resources, err := clientset.Discovery().ServerResourcesForGroupVersion(storkGroupVersion)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			err = fmt.Errorf("%v is not served, Stork is not installed", storkGroupVersion)
+		}
+		d.add(api.ClusterDiagnosticCheck_StorkCRDs, storkGroupVersion, err)
+		return
+	}
+	served := make(map[string]bool)
+	for _, resource := range resources.APIResources {
+		served[resource.Name] = true
+	}
+	for _, resource := range StorkResources {
+		var err error
+		if !served[resource] {
+			err = fmt.Errorf("%v.%v is not served, the Stork CRD is not installed", resource, storkGroup)
+		}
+		d.add(api.ClusterDiagnosticCheck_StorkCRDs, resource+"."+storkGroup, err)
+	}
+}
+
+func serverURL(config *rest.Config) (*url.URL, error) {
+	if config == nil || config.Host == "" {
+		return nil, fmt.Errorf("server not set in the kubeconfig")
+	}
+	host := config.Host
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, fmt.Errorf("invalid server %v: %v", config.Host, err)
+	}
+	if u.Hostname() == "" {
+		return nil, fmt.Errorf("invalid server %v: host not set", config.Host)
+	}
+	return u, nil
+}
+
+// proxyFuncFor returns the proxy function used by the transports of the
+// rest config
+func proxyFuncFor(config *rest.Config) func(*http.Request) (*url.URL, error) {
+	proxyFunc := http.ProxyFromEnvironment
+	if config.Proxy != nil {
+		proxyFunc = config.Proxy
+	}
+	return utilnet.NewProxierWithNoProxyCIDR(proxyFunc)
+}
+
+// dialThroughProxy returns a connection to the address tunneled through
+// the proxy. SOCKS5 proxies and HTTP(S) proxies supporting CONNECT are
+// supported, as by the transports of the rest configs
+func dialThroughProxy(ctx context.Context, proxyURL *url.URL, address string) (net.Conn, error) {
+	switch proxyURL.Scheme {
+	case "socks5", "socks5h":
+		dialer, err := proxy.FromURL(proxyURL, &net.Dialer{})
+		if err != nil {
+			return nil, err
+		}
+		if contextDialer, ok := dialer.(proxy.ContextDialer); ok {
+			return contextDialer.DialContext(ctx, "tcp", address)
+		}
+		return dialer.Dial("tcp", address)
+	case "http", "https":
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme %v", proxyURL.Scheme)
+	}
+
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", hostPort(proxyURL))
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	if proxyURL.Scheme == "https" {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: proxyURL.Hostname()})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("TLS handshake with the proxy failed: %v", err)
+		}
+		conn = tlsConn
+	}
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: address},
+		Host:   address,
+		Header: make(http.Header),
+	}
+	if proxyURL.User != nil {
+		password, _ := proxyURL.User.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(proxyURL.User.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		// The body of the successful responses is the tunnel
+		resp.Body.Close()
+		conn.Close()
+		return nil, fmt.Errorf("the proxy refused to connect: %v", resp.Status)
+	}
+	conn.SetDeadline(time.Time{})
+	return conn, nil
+}
+
+func hostPort(u *url.URL) string {
+	port := u.Port()
+	if port == "" {
+		port = "443"
+		if u.Scheme == "http" {
+			port = "80"
+		}
+	}
+	return net.JoinHostPort(u.Hostname(), port)
+}
+
+// tlsError adds the cause of the common certificate verification failures
+func tlsError(err error) error {
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostname x509.HostnameError
+	var invalid x509.CertificateInvalidError
+	switch {
+	case errors.As(err, &unknownAuthority):
+		return fmt.Errorf("the server certificate is not signed by the CA of the kubeconfig: %v", err)
+	case errors.As(err, &hostname):
+		return fmt.Errorf("the server certificate is not valid for the server host: %v", err)
+	case errors.As(err, &invalid):
+		return fmt.Errorf("the server certificate is invalid or expired: %v", err)
+	}
+	return err
+}
@@ -0,0 +1,191 @@
+package kubeauth_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+	"github.com/portworx/px-backup-api/pkg/kubeauth"
+	"github.com/portworx/px-backup-api/pkg/kubeauth/fake"
+	"k8s.io/client-go/rest"
+)
+
+func restConfigFor(server *fake.APIServer) *rest.Config {
+	return &rest.Config{
+		Host:            server.URL,
+		BearerToken:     "token",
+		TLSClientConfig: rest.TLSClientConfig{CAData: server.CAData()},
+	}
+}
+
+// checksWithStatus returns the stage and name of the checks with the
+// status
+func checksWithStatus(checks []*api.ClusterDiagnosticCheck, status api.ClusterDiagnosticCheck_StatusInfo_Status) []string {
+	names := make([]string, 0)
+	for _, check := range checks {
+		if check.GetStatus().GetStatus() == status {
+			names = append(names, check.GetStage().String()+" "+check.GetName())
+		}
+	}
+	return names
+}
+
+func TestDiagnose(t *testing.T) {
+	tests := []struct {
+		name string
+		// setup configures the API server and returns the rest config
+		setup    func(server *fake.APIServer) *rest.Config
+		failed   []string
+		skipped  []string
+		contains string
+	}{
+		{
+			name: "unreachable",
+			setup: func(server *fake.APIServer) *rest.Config {
+				config := restConfigFor(server)
+				server.Close()
+				return config
+			},
+			failed:   []string{"Reachability connect "},
+			skipped:  []string{"TLS TLS", "Authentication Authentication", "Authorization Authorization", "StorkCRDs StorkCRDs"},
+			contains: "Reachability stage failed",
+		},
+		{
+			name: "unknown certificate authority",
+			setup: func(server *fake.APIServer) *rest.Config {
+				config := restConfigFor(server)
+				config.CAData = nil
+				return config
+			},
+			failed:   []string{"TLS verify server certificate"},
+			skipped:  []string{"Authentication Authentication", "Authorization Authorization", "StorkCRDs StorkCRDs"},
+			contains: "not signed by the CA of the kubeconfig",
+		},
+		{
+			name: "rejected credentials",
+			setup: func(server *fake.APIServer) *rest.Config {
+				server.SetToken("other")
+				return restConfigFor(server)
+			},
+			failed:   []string{"Authentication get server groups"},
+			skipped:  []string{"Authorization Authorization", "StorkCRDs StorkCRDs"},
+			contains: "the credentials were rejected by the API server v1.25.0",
+		},
+		{
+			name: "access denied",
+			setup: func(server *fake.APIServer) *rest.Config {
+				server.Deny(
+					kubeauth.ResourceAccess{Verb: "create", Resource: "secrets"},
+					kubeauth.ResourceAccess{Verb: "delete", Group: "stork.libopenstorage.org", Resource: "backuplocations"},
+				)
+				return restConfigFor(server)
+			},
+			failed: []string{
+				"Authorization create secrets",
+				"Authorization delete backuplocations.stork.libopenstorage.org",
+			},
+			skipped:  []string{"StorkCRDs StorkCRDs"},
+			contains: "access denied: denied by the fake API server",
+		},
+		{
+			name:     "stork not installed",
+			setup:    restConfigFor,
+			failed:   []string{"StorkCRDs stork.libopenstorage.org/v1alpha1"},
+			skipped:  []string{},
+			contains: "Stork is not installed",
+		},
+		{
+			name: "missing stork CRD",
+			setup: func(server *fake.APIServer) *rest.Config {
+				server.SetStorkResources("applicationbackups", "applicationrestores", "applicationbackupschedules")
+				return restConfigFor(server)
+			},
+			failed:   []string{"StorkCRDs backuplocations.stork.libopenstorage.org"},
+			skipped:  []string{},
+			contains: "the Stork CRD is not installed",
+		},
+		{
+			name: "passed",
+			setup: func(server *fake.APIServer) *rest.Config {
+				server.SetToken("token")
+				server.SetStorkResources(kubeauth.StorkResources...)
+				return restConfigFor(server)
+			},
+			failed:  []string{},
+			skipped: []string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := fake.NewAPIServer("v1.25.0")
+			defer server.Close()
+			checks := kubeauth.Diagnose(context.Background(), test.setup(server))
+
+			failed := checksWithStatus(checks, api.ClusterDiagnosticCheck_StatusInfo_Failed)
+			if len(failed) != len(test.failed) {
+				t.Fatalf("expected the failed checks %q, got %q", test.failed, failed)
+			}
+			for i := range failed {
+				if !strings.HasPrefix(failed[i], test.failed[i]) {
+					t.Errorf("expected the failed checks %q, got %q", test.failed, failed)
+				}
+			}
+			skipped := checksWithStatus(checks, api.ClusterDiagnosticCheck_StatusInfo_Skipped)
+			if strings.Join(skipped, ",") != strings.Join(test.skipped, ",") {
+				t.Errorf("expected the skipped checks %q, got %q", test.skipped, skipped)
+			}
+			reason := kubeauth.DiagnosticsReason(checks)
+			if test.contains == "" && reason != "" {
+				t.Errorf("expected no reason, got %v", reason)
+			}
+			if !strings.Contains(reason, test.contains) {
+				t.Errorf("expected the reason to contain %q, got %v", test.contains, reason)
+			}
+			stages := make([]string, 0, len(test.skipped))
+			for _, check := range test.skipped {
+				stages = append(stages, strings.Fields(check)[0])
+			}
+			if len(stages) > 0 && !strings.HasSuffix(reason, "; skipped stages: "+strings.Join(stages, ", ")) {
+				t.Errorf("expected the reason to name the skipped stages %v, got %v", stages, reason)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsReason(t *testing.T) {
+	check := func(stage api.ClusterDiagnosticCheck_Stage, name string, status api.ClusterDiagnosticCheck_StatusInfo_Status, reason string) *api.ClusterDiagnosticCheck {
+		return &api.ClusterDiagnosticCheck{
+			Stage:  stage,
+			Name:   name,
+			Status: &api.ClusterDiagnosticCheck_StatusInfo{Status: status, Reason: reason},
+		}
+	}
+	checks := []*api.ClusterDiagnosticCheck{
+		check(api.ClusterDiagnosticCheck_Reachability, "connect", api.ClusterDiagnosticCheck_StatusInfo_Passed, ""),
+		check(api.ClusterDiagnosticCheck_TLS, "verify server certificate", api.ClusterDiagnosticCheck_StatusInfo_Warning, "insecure"),
+		check(api.ClusterDiagnosticCheck_Authorization, "create secrets", api.ClusterDiagnosticCheck_StatusInfo_Failed, "access denied"),
+		check(api.ClusterDiagnosticCheck_Authorization, "list namespaces", api.ClusterDiagnosticCheck_StatusInfo_Passed, ""),
+		check(api.ClusterDiagnosticCheck_Authorization, "create namespaces", api.ClusterDiagnosticCheck_StatusInfo_Failed, "access denied"),
+		// The failures of the later stages are not reported
+		check(api.ClusterDiagnosticCheck_StorkCRDs, "stork", api.ClusterDiagnosticCheck_StatusInfo_Failed, "not installed"),
+	}
+	expected := `Authorization stage failed: check "create secrets" failed: access denied; check "create namespaces" failed: access denied`
+	if reason := kubeauth.DiagnosticsReason(checks); reason != expected {
+		t.Errorf("expected reason %q, got %q", expected, reason)
+	}
+
+	checks = []*api.ClusterDiagnosticCheck{
+		check(api.ClusterDiagnosticCheck_Reachability, "resolve host", api.ClusterDiagnosticCheck_StatusInfo_Failed, "no such host"),
+		check(api.ClusterDiagnosticCheck_TLS, "TLS", api.ClusterDiagnosticCheck_StatusInfo_Skipped, "a check of a prior stage failed"),
+		check(api.ClusterDiagnosticCheck_StorkCRDs, "StorkCRDs", api.ClusterDiagnosticCheck_StatusInfo_Skipped, "a check of a prior stage failed"),
+	}
+	expected = `Reachability stage failed: check "resolve host" failed: no such host; skipped stages: TLS, StorkCRDs`
+	if reason := kubeauth.DiagnosticsReason(checks); reason != expected {
+		t.Errorf("expected reason %q, got %q", expected, reason)
+	}
+
+	if reason := kubeauth.DiagnosticsReason(checks[1:]); reason != "" {
+		t.Errorf("expected no reason without failed checks, got %q", reason)
+	}
+}
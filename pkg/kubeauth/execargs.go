@@ -0,0 +1,62 @@
+package kubeauth
+
+import (
+	"path"
+	"strings"
+)
+
+// matchExecCommand returns true if the exec credential plugin command and
+// args match the entry, which is either a command or "<command> <first-arg>".
+// Only the base name of the command is compared
+func matchExecCommand(entry string, command string, args []string) bool {
+	name, firstArg := entry, ""
+	if idx := strings.Index(entry, " "); idx >= 0 {
+		name, firstArg = entry[:idx], strings.TrimSpace(entry[idx+1:])
+	}
+	if path.Base(command) != name {
+		return false
+	}
+	return firstArg == "" || (len(args) > 0 && args[0] == firstArg)
+}
+
+// ExecArg returns the value of the given flag from the args of an exec
+// credential plugin. The flag can be passed as "--name value" or as
+// "--name=value". The optional short name is only matched in the first form
+func ExecArg(args []string, name string, shortName string) string {
+	for idx, arg := range args {
+		if (arg == name || (shortName != "" && arg == shortName)) && idx+1 < len(args) {
+			return args[idx+1]
+		}
+		if strings.HasPrefix(arg, name+"=") {
+			return strings.TrimPrefix(arg, name+"=")
+		}
+	}
+	return ""
+}
+
+// SetExecArg sets the value of the given flag in the args of an exec
+// credential plugin, adding the flag if it is not present
+func SetExecArg(args []string, name string, shortName string, value string) []string {
+	newArgs := make([]string, 0, len(args)+2)
+	found := false
+	for idx := 0; idx < len(args); idx++ {
+		arg := args[idx]
+		if arg == name || (shortName != "" && arg == shortName) {
+			newArgs = append(newArgs, name, value)
+			// skip the old value
+			idx++
+			found = true
+			continue
+		}
+		if strings.HasPrefix(arg, name+"=") {
+			newArgs = append(newArgs, name+"="+value)
+			found = true
+			continue
+		}
+		newArgs = append(newArgs, arg)
+	}
+	if !found {
+		newArgs = append(newArgs, name, value)
+	}
+	return newArgs
+}
@@ -0,0 +1,188 @@
+package kubeauth
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// ErrCredentialExpiryUnknown is returned when the expiry of the credentials
+// of a kubeconfig cannot be known from the kubeconfig, as the credentials
+// are fetched by an exec plugin or an auth provider, or read from files
+var ErrCredentialExpiryUnknown = errors.New("the credential expiry is not known as the credentials are fetched by an exec plugin or auth provider, or read from files")
+
+// execCredential is the subset of the ExecCredential returned by the exec
+// credential plugins which is used for finding the credential expiry
+type execCredential struct {
+	Kind   string `json:"kind"`
+	Status *struct {
+		ExpirationTimestamp   *metav1.Time `json:"expirationTimestamp,omitempty"`
+		Token                 string       `json:"token,omitempty"`
+		ClientCertificateData string       `json:"clientCertificateData,omitempty"`
+	} `json:"status,omitempty"`
+}
+
+// CredentialExpiry parses the kubeconfig and returns the expiry of the
+// credentials of its current context. It returns nil if the credentials
+// do not expire, and ErrCredentialExpiryUnknown for the credentials of the
+// exec plugins and auth providers, and the credentials read from files
+func CredentialExpiry(kubeconfig string) (*time.Time, error) {
+	config, err := clientcmd.Load([]byte(kubeconfig))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig: %v", err)
+	}
+	return ConfigCredentialExpiry(config)
+}
+
+// ConfigCredentialExpiry returns the expiry of the credentials of the
+// current context of the parsed kubeconfig. The earliest expiry is
+// returned if both a client certificate and a token are set. It returns
+// nil if the credentials do not expire, and ErrCredentialExpiryUnknown if
+// they are fetched by an exec plugin or an auth provider, or if the client
+// certificate or the token is read from a file
+func ConfigCredentialExpiry(config *clientcmdapi.Config) (*time.Time, error) {
+	if config == nil {
+		return nil, nil
+	}
+	kubeContext, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		return nil, fmt.Errorf("current context %q not found in kubeconfig", config.CurrentContext)
+	}
+	authInfo, ok := config.AuthInfos[kubeContext.AuthInfo]
+	if !ok {
+		return nil, fmt.Errorf("user %q not found in kubeconfig", kubeContext.AuthInfo)
+	}
+	if authInfo.Exec != nil || authInfo.AuthProvider != nil {
+		return nil, ErrCredentialExpiryUnknown
+	}
+	// The data takes precedence over the file paths, which are not read
+	if (len(authInfo.ClientCertificateData) == 0 && authInfo.ClientCertificate != "") ||
+		(authInfo.Token == "" && authInfo.TokenFile != "") {
+		return nil, ErrCredentialExpiryUnknown
+	}
+	var expiry *time.Time
+	if len(authInfo.ClientCertificateData) > 0 {
+		certExpiry, err := CertificateExpiry(authInfo.ClientCertificateData)
+		if err != nil {
+			return nil, err
+		}
+		expiry = earliest(expiry, certExpiry)
+	}
+	if authInfo.Token != "" {
+		expiry = earliest(expiry, TokenExpiry(authInfo.Token))
+	}
+	return expiry, nil
+}
+
+// ExecCredentialExpiry returns the expiry of the ExecCredential returned by
+// an exec credential plugin. The expirationTimestamp is used if set, else
+// the expiry of the returned client certificate or token
+func ExecCredentialExpiry(output []byte) (*time.Time, error) {
+	cred := &execCredential{}
+	if err := json.Unmarshal(output, cred); err != nil {
+		return nil, fmt.Errorf("failed to parse exec credential: %v", err)
+	}
+	if cred.Kind != "ExecCredential" {
+		return nil, fmt.Errorf("unexpected kind %q in exec credential", cred.Kind)
+	}
+	if cred.Status == nil {
+		return nil, nil
+	}
+	if cred.Status.ExpirationTimestamp != nil && !cred.Status.ExpirationTimestamp.IsZero() {
+		expiry := cred.Status.ExpirationTimestamp.Time
+		return &expiry, nil
+	}
+	var expiry *time.Time
+	if cred.Status.ClientCertificateData != "" {
+		certExpiry, err := CertificateExpiry([]byte(cred.Status.ClientCertificateData))
+		if err != nil {
+			return nil, err
+		}
+		expiry = earliest(expiry, certExpiry)
+	}
+	if cred.Status.Token != "" {
+		expiry = earliest(expiry, TokenExpiry(cred.Status.Token))
+	}
+	return expiry, nil
+}
+
+// CertificateExpiry returns the earliest expiry of the PEM encoded
+// certificates
+func CertificateExpiry(certPEM []byte) (*time.Time, error) {
+	var expiry *time.Time
+	for block, rest := pem.Decode(certPEM); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse client certificate: %v", err)
+		}
+		notAfter := cert.NotAfter
+		expiry = earliest(expiry, &notAfter)
+	}
+	if expiry == nil {
+		return nil, fmt.Errorf("no certificate found in client certificate data")
+	}
+	return expiry, nil
+}
+
+// TokenExpiry returns the expiry from the exp claim of a JWT bearer token.
+// It returns nil for the tokens which are not JWTs or have no expiry, like
+// the legacy service account tokens
+func TokenExpiry(token string) *time.Time {
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 {
+		return nil
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil
+	}
+	claims := struct {
+		Exp int64 `json:"exp"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return nil
+	}
+	expiry := time.Unix(claims.Exp, 0)
+	return &expiry
+}
+
+// CredentialExpiresWithin returns true if the credential expiry of the
+// cluster is within the window from now, including the clusters whose
+// credentials have already expired. It is used for the
+// credential_expires_within enumerate filter. The clusters without a
+// credential expiry are never matched, whether their credentials do not
+// expire or their expiry is unknown (ErrCredentialExpiryUnknown)
+func CredentialExpiresWithin(clusterInfo *api.ClusterInfo, window time.Duration, now time.Time) bool {
+	if clusterInfo.GetCredentialExpiry() == nil {
+		return false
+	}
+	expiry, err := types.TimestampFromProto(clusterInfo.GetCredentialExpiry())
+	if err != nil {
+		return false
+	}
+	return !expiry.After(now.Add(window))
+}
+
+func earliest(expiry *time.Time, other *time.Time) *time.Time {
+	if other == nil {
+		return expiry
+	}
+	if expiry == nil || other.Before(*expiry) {
+		return other
+	}
+	return expiry
+}
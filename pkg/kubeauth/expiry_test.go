@@ -0,0 +1,191 @@
+package kubeauth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// newCertificate returns a PEM encoded self-signed certificate which
+// expires at notAfter
+func newCertificate(t *testing.T, notAfter time.Time) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate a key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "kubeauth-test"},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to generate a certificate: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+// newJWT returns an unsigned JWT with the claims
+func newJWT(claims map[string]interface{}) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	payload, _ := json.Marshal(claims)
+	return header + "." + base64.RawURLEncoding.EncodeToString(payload) + ".signature"
+}
+
+func configWithUser(authInfo *clientcmdapi.AuthInfo) *clientcmdapi.Config {
+	config := clientcmdapi.NewConfig()
+	config.Clusters["c"] = &clientcmdapi.Cluster{Server: "https://cluster.example.com"}
+	config.AuthInfos["u"] = authInfo
+	config.Contexts["ctx"] = &clientcmdapi.Context{Cluster: "c", AuthInfo: "u"}
+	config.CurrentContext = "ctx"
+	return config
+}
+
+func checkExpiry(t *testing.T, expected *time.Time, actual *time.Time) {
+	t.Helper()
+	switch {
+	case expected == nil && actual != nil:
+		t.Errorf("expected no expiry, got %v", actual)
+	case expected != nil && actual == nil:
+		t.Errorf("expected expiry %v, got none", expected)
+	case expected != nil && !expected.Equal(*actual):
+		t.Errorf("expected expiry %v, got %v", expected, actual)
+	}
+}
+
+func TestConfigCredentialExpiry(t *testing.T) {
+	certExpiry := time.Now().Add(48 * time.Hour).Truncate(time.Second).UTC()
+	tokenExpiry := time.Now().Add(time.Hour).Truncate(time.Second)
+	cert := newCertificate(t, certExpiry)
+	tests := []struct {
+		name     string
+		authInfo *clientcmdapi.AuthInfo
+		expected *time.Time
+		err      error
+	}{
+		{
+			name:     "client certificate",
+			authInfo: &clientcmdapi.AuthInfo{ClientCertificateData: cert},
+			expected: &certExpiry,
+		},
+		{
+			name:     "JWT with exp",
+			authInfo: &clientcmdapi.AuthInfo{Token: newJWT(map[string]interface{}{"sub": "user", "exp": tokenExpiry.Unix()})},
+			expected: &tokenExpiry,
+		},
+		{
+			name:     "JWT without exp",
+			authInfo: &clientcmdapi.AuthInfo{Token: newJWT(map[string]interface{}{"sub": "user"})},
+		},
+		{
+			name:     "opaque token",
+			authInfo: &clientcmdapi.AuthInfo{Token: "opaque"},
+		},
+		{
+			name: "earliest of the certificate and the token",
+			authInfo: &clientcmdapi.AuthInfo{
+				ClientCertificateData: cert,
+				Token:                 newJWT(map[string]interface{}{"exp": tokenExpiry.Unix()}),
+			},
+			expected: &tokenExpiry,
+		},
+		{
+			name:     "exec",
+			authInfo: &clientcmdapi.AuthInfo{Exec: &clientcmdapi.ExecConfig{Command: "aws"}},
+			err:      ErrCredentialExpiryUnknown,
+		},
+		{
+			name:     "auth provider",
+			authInfo: &clientcmdapi.AuthInfo{AuthProvider: &clientcmdapi.AuthProviderConfig{Name: "gcp"}},
+			err:      ErrCredentialExpiryUnknown,
+		},
+		{
+			name:     "client certificate file",
+			authInfo: &clientcmdapi.AuthInfo{ClientCertificate: "/etc/kubernetes/admin.crt", ClientKey: "/etc/kubernetes/admin.key"},
+			err:      ErrCredentialExpiryUnknown,
+		},
+		{
+			name:     "token file",
+			authInfo: &clientcmdapi.AuthInfo{TokenFile: "/var/run/secrets/token"},
+			err:      ErrCredentialExpiryUnknown,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expiry, err := ConfigCredentialExpiry(configWithUser(test.authInfo))
+			if err != test.err {
+				t.Fatalf("expected error %v, got %v", test.err, err)
+			}
+			checkExpiry(t, test.expected, expiry)
+		})
+	}
+
+	config := configWithUser(&clientcmdapi.AuthInfo{})
+	config.CurrentContext = "missing"
+	if _, err := ConfigCredentialExpiry(config); err == nil {
+		t.Errorf("expected a missing context to fail")
+	}
+}
+
+func TestExecCredentialExpiry(t *testing.T) {
+	expirationTimestamp := time.Now().Add(15 * time.Minute).Truncate(time.Second).UTC()
+	tokenExpiry := time.Now().Add(time.Hour).Truncate(time.Second)
+	execCredential := func(status map[string]interface{}) []byte {
+		output, _ := json.Marshal(map[string]interface{}{
+			"apiVersion": "client.authentication.k8s.io/v1beta1",
+			"kind":       "ExecCredential",
+			"status":     status,
+		})
+		return output
+	}
+	tests := []struct {
+		name     string
+		output   []byte
+		expected *time.Time
+		fails    bool
+	}{
+		{
+			name: "with expirationTimestamp",
+			output: execCredential(map[string]interface{}{
+				"token":               newJWT(map[string]interface{}{"exp": tokenExpiry.Unix()}),
+				"expirationTimestamp": expirationTimestamp.Format(time.RFC3339),
+			}),
+			expected: &expirationTimestamp,
+		},
+		{
+			name:     "without expirationTimestamp",
+			output:   execCredential(map[string]interface{}{"token": newJWT(map[string]interface{}{"exp": tokenExpiry.Unix()})}),
+			expected: &tokenExpiry,
+		},
+		{
+			name:   "without expirationTimestamp and token expiry",
+			output: execCredential(map[string]interface{}{"token": "opaque"}),
+		},
+		{
+			name:   "not an ExecCredential",
+			output: []byte(`{"kind":"Config"}`),
+			fails:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expiry, err := ExecCredentialExpiry(test.output)
+			if (err != nil) != test.fails {
+				t.Fatalf("expected failure %v, got %v", test.fails, err)
+			}
+			checkExpiry(t, test.expected, expiry)
+		})
+	}
+}
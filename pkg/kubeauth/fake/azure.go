@@ -0,0 +1,286 @@
+package fake
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	aksSucceededState      = "Succeeded"
+	aksManagedClustersType = "Microsoft.ContainerService/managedClusters"
+	aksServerID            = "6dae42f8-4368-4678-94ff-3960e28e3630"
+	aksKubeloginClientID   = "80faf920-1908-4b52-b5ef-a8e7bedfc67a"
+	aadTokenLifetime       = time.Hour
+	aadClientCredentials   = "client_credentials"
+	armScopePrefix         = "https://management.azure.com"
+)
+
+// AzureCredential is the service principal accepted by the fake Azure
+type AzureCredential struct {
+	TenantID       string
+	ClientID       string
+	ClientSecret   string
+	SubscriptionID string
+}
+
+// Azure is a fake of the Azure AD token endpoint and of the ARM endpoints
+// used for listing the AKS clusters of a subscription and for fetching
+// their user kubeconfigs. The clusters are returned with Azure AD enabled,
+// so their kubeconfigs use the kubelogin exec plugin
+type Azure struct {
+	server     *httptest.Server
+	credential AzureCredential
+
+	lock     sync.Mutex
+	clusters []*Cluster
+	// tokens maps the issued access tokens to their scope
+	tokens map[string]string
+	// pageSize is the number of clusters returned per list call, all
+	// the clusters are returned at once if it is not set
+	pageSize int
+}
+
+// NewAzure starts a fake Azure which accepts the given service principal
+// and serves the given clusters in its subscription. The clusters are
+// placed in their ResourceGroup. It should be closed once it is no longer
+// needed
+func NewAzure(credential AzureCredential, clusters ...*Cluster) *Azure {
+	a := &Azure{
+		credential: credential,
+		clusters:   clusters,
+		tokens:     make(map[string]string),
+	}
+	a.server = httptest.NewServer(http.HandlerFunc(a.serveHTTP))
+	return a
+}
+
+// URL returns the endpoint to be set as both the ARM and the login
+// endpoints through azure.SetEndpoints
+func (a *Azure) URL() string {
+	return a.server.URL
+}
+
+// Close shuts down the fake
+func (a *Azure) Close() {
+	a.server.Close()
+}
+
+// AddCluster adds a cluster to the fake
+func (a *Azure) AddCluster(cluster *Cluster) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.clusters = append(a.clusters, cluster)
+}
+
+// SetPageSize sets the number of clusters returned per list call
+func (a *Azure) SetPageSize(pageSize int) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.pageSize = pageSize
+}
+
+// ClusterID returns the ARM ID of the cluster
+func (a *Azure) ClusterID(cluster *Cluster) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/%s/%s",
+		a.credential.SubscriptionID, cluster.ResourceGroup, aksManagedClustersType, cluster.Name)
+}
+
+func (a *Azure) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasSuffix(r.URL.Path, "/oauth2/v2.0/token") {
+		a.issueToken(w, r)
+		return
+	}
+	if !a.authorized(r) {
+		writeARMError(w, http.StatusUnauthorized, "InvalidAuthenticationToken", "The access token is invalid.")
+		return
+	}
+	// /subscriptions/<id>[/resourceGroups/<rg>]/providers/Microsoft.ContainerService/managedClusters[/<name>/listClusterUserCredential]
+	tokens := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(tokens) < 2 || tokens[0] != "subscriptions" {
+		writeARMError(w, http.StatusNotFound, "InvalidResourceType", "unsupported path "+r.URL.Path)
+		return
+	}
+	if subscriptionID, _ := url.PathUnescape(tokens[1]); subscriptionID != a.credential.SubscriptionID {
+		writeARMError(w, http.StatusNotFound, "SubscriptionNotFound", "The subscription "+subscriptionID+" could not be found.")
+		return
+	}
+	tokens = tokens[2:]
+	var resourceGroup string
+	if len(tokens) >= 2 && strings.EqualFold(tokens[0], "resourceGroups") {
+		resourceGroup, _ = url.PathUnescape(tokens[1])
+		tokens = tokens[2:]
+	}
+	if len(tokens) < 3 || tokens[0] != "providers" || tokens[1]+"/"+tokens[2] != aksManagedClustersType {
+		writeARMError(w, http.StatusNotFound, "InvalidResourceType", "unsupported path "+r.URL.Path)
+		return
+	}
+	tokens = tokens[3:]
+	switch {
+	case len(tokens) == 0 && r.Method == http.MethodGet:
+		a.listClusters(w, r, resourceGroup)
+	case len(tokens) == 2 && tokens[1] == "listClusterUserCredential" && r.Method == http.MethodPost && resourceGroup != "":
+		a.listClusterUserCredential(w, resourceGroup, tokens[0])
+	default:
+		writeARMError(w, http.StatusNotFound, "InvalidResourceType", "unsupported path "+r.URL.Path)
+	}
+}
+
+func (a *Azure) issueToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, oauthError("invalid_request", err.Error()))
+		return
+	}
+	tenantID := strings.Split(strings.Trim(r.URL.Path, "/"), "/")[0]
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if r.PostForm.Get("grant_type") != aadClientCredentials {
+		writeJSON(w, http.StatusBadRequest, oauthError("unsupported_grant_type", "AADSTS70003: The app requested an unsupported grant type."))
+		return
+	}
+	if tenantID != a.credential.TenantID {
+		writeJSON(w, http.StatusBadRequest, oauthError("invalid_request", "AADSTS90002: Tenant '"+tenantID+"' not found."))
+		return
+	}
+	if clientID != a.credential.ClientID || clientSecret != a.credential.ClientSecret {
+		writeJSON(w, http.StatusUnauthorized, oauthError("invalid_client", "AADSTS7000215: Invalid client secret provided."))
+		return
+	}
+	scope := r.PostForm.Get("scope")
+	token := newToken(clientID, aadTokenLifetime)
+	a.lock.Lock()
+	a.tokens[token] = scope
+	a.lock.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": token,
+		"token_type":   "Bearer",
+		"expires_in":   int64(aadTokenLifetime / time.Second),
+	})
+}
+
+// authorized returns true if the request carries a token issued for ARM
+func (a *Azure) authorized(r *http.Request) bool {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	scope, ok := a.tokens[bearerToken(r)]
+	return ok && strings.HasPrefix(scope, armScopePrefix)
+}
+
+func (a *Azure) listClusters(w http.ResponseWriter, r *http.Request, resourceGroup string) {
+	a.lock.Lock()
+	pageSize := a.pageSize
+	clusters := make([]*Cluster, 0)
+	for _, cluster := range a.clusters {
+		if resourceGroup == "" || strings.EqualFold(cluster.ResourceGroup, resourceGroup) {
+			clusters = append(clusters, cluster)
+		}
+	}
+	a.lock.Unlock()
+
+	offset := 0
+	if value := r.URL.Query().Get("$skipToken"); value != "" {
+		var err error
+		if offset, err = strconv.Atoi(value); err != nil || offset < 0 || offset > len(clusters) {
+			writeARMError(w, http.StatusBadRequest, "InvalidSkipToken", "invalid $skipToken "+value)
+			return
+		}
+	}
+	end := len(clusters)
+	resp := map[string]interface{}{}
+	if pageSize > 0 && offset+pageSize < len(clusters) {
+		end = offset + pageSize
+		query := r.URL.Query()
+		query.Set("$skipToken", strconv.Itoa(end))
+		resp["nextLink"] = a.server.URL + r.URL.Path + "?" + query.Encode()
+	}
+	value := make([]interface{}, 0, end-offset)
+	for _, cluster := range clusters[offset:end] {
+		value = append(value, map[string]interface{}{
+			"id":       a.ClusterID(cluster),
+			"name":     cluster.Name,
+			"type":     aksManagedClustersType,
+			"location": cluster.Region,
+			"properties": map[string]interface{}{
+				"kubernetesVersion":        cluster.Version,
+				"currentKubernetesVersion": cluster.Version,
+				"provisioningState":        cluster.status(aksSucceededState),
+			},
+		})
+	}
+	resp["value"] = value
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (a *Azure) listClusterUserCredential(w http.ResponseWriter, resourceGroup string, name string) {
+	a.lock.Lock()
+	var found *Cluster
+	for _, cluster := range a.clusters {
+		if cluster.Name == name && strings.EqualFold(cluster.ResourceGroup, resourceGroup) {
+			found = cluster
+			break
+		}
+	}
+	a.lock.Unlock()
+	if found == nil {
+		writeARMError(w, http.StatusNotFound, "ResourceNotFound",
+			fmt.Sprintf("The Resource '%s/%s' under resource group '%s' was not found.", aksManagedClustersType, name, resourceGroup))
+		return
+	}
+	userName := fmt.Sprintf("clusterUser_%s_%s", found.ResourceGroup, found.Name)
+	kubeconfig := fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: %[1]s
+clusters:
+- name: %[1]s
+  cluster:
+    certificate-authority-data: %[2]s
+    server: %[3]s
+contexts:
+- name: %[1]s
+  context:
+    cluster: %[1]s
+    user: %[4]s
+users:
+- name: %[4]s
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: kubelogin
+      args:
+      - get-token
+      - --environment
+      - AzurePublicCloud
+      - --server-id
+      - %[5]s
+      - --client-id
+      - %[6]s
+      - --tenant-id
+      - %[7]s
+      - --login
+      - devicecode
+`, found.Name, base64.StdEncoding.EncodeToString(found.caData()), found.server(), userName,
+		aksServerID, aksKubeloginClientID, a.credential.TenantID)
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"kubeconfigs": []map[string]string{{
+			"name":  "clusterUser",
+			"value": base64.StdEncoding.EncodeToString([]byte(kubeconfig)),
+		}},
+	})
+}
+
+func writeARMError(w http.ResponseWriter, status int, code string, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"error": map[string]string{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
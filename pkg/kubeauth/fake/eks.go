@@ -0,0 +1,166 @@
+package fake
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	eksActiveStatus   = "ACTIVE"
+	eksAccountID      = "123456789012"
+	eksDefaultMaxSize = 100
+)
+
+// EKS is a fake of the EKS ListClusters and DescribeCluster APIs. The
+// clusters are filtered by the region the requests are signed for
+type EKS struct {
+	server   *httptest.Server
+	lock     sync.Mutex
+	clusters []*Cluster
+}
+
+// NewEKS starts a fake EKS serving the given clusters. It should be
+// closed once it is no longer needed
+func NewEKS(clusters ...*Cluster) *EKS {
+	e := &EKS{clusters: clusters}
+	e.server = httptest.NewServer(http.HandlerFunc(e.serveHTTP))
+	return e
+}
+
+// URL returns the endpoint to be set through aws.SetEndpoint
+func (e *EKS) URL() string {
+	return e.server.URL
+}
+
+// Close shuts down the fake
+func (e *EKS) Close() {
+	e.server.Close()
+}
+
+// AddCluster adds a cluster to the fake
+func (e *EKS) AddCluster(cluster *Cluster) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.clusters = append(e.clusters, cluster)
+}
+
+func (e *EKS) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	region := signingRegion(r)
+	if region == "" {
+		writeEKSError(w, http.StatusForbidden, "MissingAuthenticationTokenException", "request is not signed")
+		return
+	}
+	if r.Method != http.MethodGet {
+		writeEKSError(w, http.StatusMethodNotAllowed, "InvalidRequestException", "unsupported method "+r.Method)
+		return
+	}
+	path := strings.Trim(r.URL.Path, "/")
+	switch {
+	case path == "clusters":
+		e.listClusters(w, r, region)
+	case strings.HasPrefix(path, "clusters/") && !strings.Contains(strings.TrimPrefix(path, "clusters/"), "/"):
+		e.describeCluster(w, region, strings.TrimPrefix(path, "clusters/"))
+	default:
+		writeEKSError(w, http.StatusNotFound, "ResourceNotFoundException", "unsupported path "+r.URL.Path)
+	}
+}
+
+func (e *EKS) listClusters(w http.ResponseWriter, r *http.Request, region string) {
+	names := make([]string, 0)
+	for _, cluster := range e.regionClusters(region) {
+		names = append(names, cluster.Name)
+	}
+	sort.Strings(names)
+
+	maxResults := eksDefaultMaxSize
+	if value := r.URL.Query().Get("maxResults"); value != "" {
+		var err error
+		if maxResults, err = strconv.Atoi(value); err != nil || maxResults < 1 {
+			writeEKSError(w, http.StatusBadRequest, "InvalidParameterException", "invalid maxResults "+value)
+			return
+		}
+	}
+	offset := 0
+	if value := r.URL.Query().Get("nextToken"); value != "" {
+		var err error
+		if offset, err = strconv.Atoi(value); err != nil || offset < 0 || offset > len(names) {
+			writeEKSError(w, http.StatusBadRequest, "InvalidParameterException", "invalid nextToken "+value)
+			return
+		}
+	}
+	resp := map[string]interface{}{}
+	end := offset + maxResults
+	if end < len(names) {
+		resp["nextToken"] = strconv.Itoa(end)
+	} else {
+		end = len(names)
+	}
+	resp["clusters"] = names[offset:end]
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (e *EKS) describeCluster(w http.ResponseWriter, region string, name string) {
+	for _, cluster := range e.regionClusters(region) {
+		if cluster.Name != name {
+			continue
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"cluster": map[string]interface{}{
+				"name":     cluster.Name,
+				"arn":      fmt.Sprintf("arn:aws:eks:%s:%s:cluster/%s", region, eksAccountID, cluster.Name),
+				"endpoint": cluster.server(),
+				"status":   cluster.status(eksActiveStatus),
+				"version":  cluster.Version,
+				"certificateAuthority": map[string]string{
+					"data": base64.StdEncoding.EncodeToString(cluster.caData()),
+				},
+			},
+		})
+		return
+	}
+	writeEKSError(w, http.StatusNotFound, "ResourceNotFoundException", "No cluster found for name: "+name+".")
+}
+
+func (e *EKS) regionClusters(region string) []*Cluster {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	clusters := make([]*Cluster, 0)
+	for _, cluster := range e.clusters {
+		if cluster.Region == region {
+			clusters = append(clusters, cluster)
+		}
+	}
+	return clusters
+}
+
+// signingRegion returns the region from the credential scope of the
+// SigV4 authorization header of the request
+func signingRegion(r *http.Request) string {
+	authorization := r.Header.Get("Authorization")
+	const credentialPrefix = "Credential="
+	idx := strings.Index(authorization, credentialPrefix)
+	if idx < 0 {
+		return ""
+	}
+	credential := authorization[idx+len(credentialPrefix):]
+	if end := strings.Index(credential, ","); end >= 0 {
+		credential = credential[:end]
+	}
+	// <access-key>/<date>/<region>/<service>/aws4_request
+	scope := strings.Split(credential, "/")
+	if len(scope) != 5 {
+		return ""
+	}
+	return scope[2]
+}
+
+func writeEKSError(w http.ResponseWriter, status int, errorType string, message string) {
+	w.Header().Set("X-Amzn-Errortype", errorType)
+	writeJSON(w, status, map[string]string{"message": message})
+}
@@ -0,0 +1,308 @@
+// Package fake provides httptest based stand-ins for the cloud provider
+// endpoints used by the kubeauth plugins so that the plugins can be run
+// offline. The plugins are pointed at the fakes through the endpoint
+// overrides of the plugin packages, for example:
+//
+//	eks := fake.NewEKS(&fake.Cluster{Name: "c1", Region: "us-east-1"})
+//	defer eks.Close()
+//	aws.SetEndpoint(eks.URL())
+//	defer aws.SetEndpoint("")
+//
+// It also provides the fixtures shared by the plugin tests, like
+// AllClients for paging through the clusters of a cloud credential and
+// CheckClient for verifying the access to an APIServer
+package fake
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/portworx/px-backup-api/pkg/kubeauth"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	storkGroup        = "stork.libopenstorage.org"
+	storkGroupVersion = storkGroup + "/v1alpha1"
+	// unreachableServer is the API server reported for the clusters
+	// which are not backed by an APIServer
+	unreachableServer = "https://127.0.0.1:1"
+)
+
+// Cluster is a managed cluster served by the fakes
+type Cluster struct {
+	// Name of the cluster
+	Name string
+	// ID of the cluster. Defaults to the name
+	ID string
+	// Region of the cluster. For GKE this is the zone or region
+	// the cluster is located in
+	Region string
+	// ResourceGroup of the cluster, only used by IBM and Azure
+	ResourceGroup string
+	// VPC is set for the IBM VPC clusters
+	VPC bool
+	// Version is the Kubernetes version of the cluster
+	Version string
+	// Status of the cluster in the terms of the provider. Defaults to
+	// the running status of the provider
+	Status string
+	// Server is the API server of the cluster. The clusters without one
+	// report an unreachable API server
+	Server *APIServer
+}
+
+func (c *Cluster) id() string {
+	if c.ID != "" {
+		return c.ID
+	}
+	return c.Name
+}
+
+func (c *Cluster) status(running string) string {
+	if c.Status != "" {
+		return c.Status
+	}
+	return running
+}
+
+func (c *Cluster) server() string {
+	if c.Server != nil {
+		return c.Server.URL
+	}
+	return unreachableServer
+}
+
+func (c *Cluster) caData() []byte {
+	if c.Server != nil {
+		return c.Server.CAData()
+	}
+	return defaultCert().certPEM
+}
+
+// APIServer is a fake Kubernetes API server which serves the version
+// endpoint used for validating the cluster access, and the discovery and
+// SelfSubjectAccessReview endpoints used by the diagnostics
+type APIServer struct {
+	*httptest.Server
+	// Version is the git version returned by the version endpoint
+	Version string
+
+	lock           sync.Mutex
+	lastToken      string
+	token          string
+	denied         map[string]bool
+	storkResources []string
+}
+
+// NewAPIServer starts a fake Kubernetes API server. It should be
+// closed once it is no longer needed
+func NewAPIServer(version string) *APIServer {
+	a := &APIServer{Version: version, denied: make(map[string]bool)}
+	a.Server = httptest.NewTLSServer(http.HandlerFunc(a.serveHTTP))
+	return a
+}
+
+// CAData returns the PEM encoded CA certificate of the API server
+func (a *APIServer) CAData() []byte {
+	return pemCertificate(a.Certificate().Raw)
+}
+
+// LastToken returns the bearer token of the last request served by the
+// API server, which is empty if the request had none
+func (a *APIServer) LastToken() string {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.lastToken
+}
+
+// SetToken sets the only bearer token accepted by the API server. The
+// requests with another token are rejected with a 401, except for the
+// version endpoint which is readable anonymously. Any token is accepted
+// if it is empty
+func (a *APIServer) SetToken(token string) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.token = token
+}
+
+// Deny denies the accesses in the SelfSubjectAccessReviews. The other
+// accesses are allowed
+func (a *APIServer) Deny(access ...kubeauth.ResourceAccess) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	for _, access := range access {
+		a.denied[access.String()] = true
+	}
+}
+
+// SetStorkResources sets the resources served in the Stork group. The
+// Stork group is not served until it is set
+func (a *APIServer) SetStorkResources(resources ...string) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.storkResources = resources
+}
+
+func (a *APIServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.lastToken = bearerToken(r)
+	if r.URL.Path == "/version" {
+		writeJSON(w, http.StatusOK, map[string]string{"gitVersion": a.Version})
+		return
+	}
+	if a.token != "" && a.lastToken != a.token {
+		writeStatus(w, http.StatusUnauthorized, metav1.StatusReasonUnauthorized)
+		return
+	}
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api":
+		writeJSON(w, http.StatusOK, &metav1.APIVersions{
+			TypeMeta: metav1.TypeMeta{Kind: "APIVersions"},
+			Versions: []string{"v1"},
+		})
+	case r.Method == http.MethodGet && r.URL.Path == "/apis":
+		groups := &metav1.APIGroupList{TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"}}
+		if a.storkResources != nil {
+			version := metav1.GroupVersionForDiscovery{GroupVersion: storkGroupVersion, Version: "v1alpha1"}
+			groups.Groups = append(groups.Groups, metav1.APIGroup{
+				Name:             storkGroup,
+				Versions:         []metav1.GroupVersionForDiscovery{version},
+				PreferredVersion: version,
+			})
+		}
+		writeJSON(w, http.StatusOK, groups)
+	case r.Method == http.MethodGet && r.URL.Path == "/apis/"+storkGroupVersion && a.storkResources != nil:
+		resources := &metav1.APIResourceList{
+			TypeMeta:     metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"},
+			GroupVersion: storkGroupVersion,
+		}
+		for _, resource := range a.storkResources {
+			resources.APIResources = append(resources.APIResources, metav1.APIResource{Name: resource, Namespaced: true})
+		}
+		writeJSON(w, http.StatusOK, resources)
+	case r.Method == http.MethodPost && r.URL.Path == "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews":
+		review := &authorizationv1.SelfSubjectAccessReview{}
+		if err := json.NewDecoder(r.Body).Decode(review); err != nil || review.Spec.ResourceAttributes == nil {
+			writeStatus(w, http.StatusBadRequest, metav1.StatusReasonBadRequest)
+			return
+		}
+		attributes := review.Spec.ResourceAttributes
+		access := kubeauth.ResourceAccess{Verb: attributes.Verb, Group: attributes.Group, Resource: attributes.Resource}
+		review.Status.Allowed = !a.denied[access.String()]
+		if !review.Status.Allowed {
+			review.Status.Reason = "denied by the fake API server"
+		}
+		writeJSON(w, http.StatusCreated, review)
+	default:
+		writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound)
+	}
+}
+
+// keyPair is a self-signed certificate and its key
+type keyPair struct {
+	certPEM []byte
+	keyPEM  []byte
+}
+
+var (
+	defaultCertOnce sync.Once
+	defaultKeyPair  *keyPair
+)
+
+// defaultCert returns a self-signed certificate used for the clusters
+// which are not backed by an APIServer and for the client certificates
+func defaultCert() *keyPair {
+	defaultCertOnce.Do(func() {
+		var err error
+		if defaultKeyPair, err = newKeyPair("kubeauth-fake"); err != nil {
+			panic(fmt.Sprintf("failed to generate a certificate: %v", err))
+		}
+	})
+	return defaultKeyPair
+}
+
+func newKeyPair(commonName string) (*keyPair, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return &keyPair{
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}, nil
+}
+
+func pemCertificate(der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+// newToken returns an unsigned JWT with the given subject which expires
+// after the given duration
+func newToken(subject string, expiresIn time.Duration) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	claims, _ := json.Marshal(map[string]interface{}{
+		"sub": subject,
+		"iat": time.Now().Unix(),
+		"exp": time.Now().Add(expiresIn).Unix(),
+	})
+	return header + "." + base64.RawURLEncoding.EncodeToString(claims) + ".fake"
+}
+
+// bearerToken returns the bearer token of the request
+func bearerToken(r *http.Request) string {
+	authorization := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, "Bearer ") {
+		return ""
+	}
+	return strings.TrimPrefix(authorization, "Bearer ")
+}
+
+// writeStatus writes a failure status as returned by the API servers
+func writeStatus(w http.ResponseWriter, code int, reason metav1.StatusReason) {
+	writeJSON(w, code, &metav1.Status{
+		TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+		Status:   metav1.StatusFailure,
+		Reason:   reason,
+		Code:     int32(code),
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
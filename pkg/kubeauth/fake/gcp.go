@@ -0,0 +1,198 @@
+package fake
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2/jws"
+)
+
+const (
+	gkeRunningStatus = "RUNNING"
+	gcpJWTGrantType  = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+	gcpTokenLifetime = time.Hour
+	// gcpAllLocations is the GKE wildcard for all the locations
+	gcpAllLocations = "-"
+)
+
+// GCP is a fake of the Google OAuth2 token endpoint and of the GKE API
+// used for listing the clusters of a project. The plugin fetches the tokens
+// from the token_uri of the json key, so the keys returned by
+// ServiceAccountKey point at the fake
+type GCP struct {
+	server    *httptest.Server
+	projectID string
+	key       *rsa.PrivateKey
+
+	lock     sync.Mutex
+	clusters []*Cluster
+	tokens   map[string]bool
+}
+
+// NewGCP starts a fake GCP serving the given clusters in the project. It
+// should be closed once it is no longer needed
+func NewGCP(projectID string, clusters ...*Cluster) (*GCP, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	g := &GCP{
+		projectID: projectID,
+		key:       key,
+		clusters:  clusters,
+		tokens:    make(map[string]bool),
+	}
+	g.server = httptest.NewServer(http.HandlerFunc(g.serveHTTP))
+	return g, nil
+}
+
+// URL returns the endpoint to be set through gcp.SetEndpoint
+func (g *GCP) URL() string {
+	return g.server.URL
+}
+
+// Close shuts down the fake
+func (g *GCP) Close() {
+	g.server.Close()
+}
+
+// AddCluster adds a cluster to the fake
+func (g *GCP) AddCluster(cluster *Cluster) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.clusters = append(g.clusters, cluster)
+}
+
+// ServiceAccountKey returns a service account json key for the project
+// whose token_uri points at the fake
+func (g *GCP) ServiceAccountKey() (string, error) {
+	keyPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(g.key),
+	})
+	key, err := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"project_id":     g.projectID,
+		"private_key_id": "kubeauth-fake",
+		"private_key":    string(keyPEM),
+		"client_email":   fmt.Sprintf("kubeauth-fake@%s.iam.gserviceaccount.com", g.projectID),
+		"client_id":      "1",
+		"token_uri":      g.server.URL + "/token",
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(key), nil
+}
+
+func (g *GCP) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/token" {
+		g.issueToken(w, r)
+		return
+	}
+	if !g.authorized(r) {
+		writeGCPError(w, http.StatusUnauthorized, "UNAUTHENTICATED", "Request had invalid authentication credentials.")
+		return
+	}
+	// /v1/projects/<project>/locations/<location>/clusters
+	tokens := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if r.Method != http.MethodGet || len(tokens) != 6 || tokens[0] != "v1" || tokens[1] != "projects" ||
+		tokens[3] != "locations" || tokens[5] != "clusters" {
+		writeGCPError(w, http.StatusNotFound, "NOT_FOUND", "unsupported path "+r.URL.Path)
+		return
+	}
+	projectID, _ := url.PathUnescape(tokens[2])
+	location, _ := url.PathUnescape(tokens[4])
+	if projectID != g.projectID {
+		writeGCPError(w, http.StatusForbidden, "PERMISSION_DENIED", "project "+projectID+" not found")
+		return
+	}
+	g.listClusters(w, location)
+}
+
+func (g *GCP) issueToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, oauthError("invalid_request", err.Error()))
+		return
+	}
+	if r.PostForm.Get("grant_type") != gcpJWTGrantType {
+		writeJSON(w, http.StatusBadRequest, oauthError("unsupported_grant_type", "Invalid grant_type"))
+		return
+	}
+	if err := jws.Verify(r.PostForm.Get("assertion"), &g.key.PublicKey); err != nil {
+		writeJSON(w, http.StatusBadRequest, oauthError("invalid_grant", "Invalid JWT Signature."))
+		return
+	}
+	token := newToken("gcp-fake", gcpTokenLifetime)
+	g.lock.Lock()
+	g.tokens[token] = true
+	g.lock.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": token,
+		"token_type":   "Bearer",
+		"expires_in":   int64(gcpTokenLifetime / time.Second),
+	})
+}
+
+func (g *GCP) authorized(r *http.Request) bool {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	return g.tokens[bearerToken(r)]
+}
+
+func (g *GCP) listClusters(w http.ResponseWriter, location string) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	clusters := make([]interface{}, 0)
+	for _, cluster := range g.clusters {
+		if location != gcpAllLocations && cluster.Region != location {
+			continue
+		}
+		endpoint := ""
+		if u, err := url.Parse(cluster.server()); err == nil {
+			// GKE returns the endpoint without the scheme
+			endpoint = u.Host
+		}
+		clusters = append(clusters, map[string]interface{}{
+			"id":                   cluster.id(),
+			"name":                 cluster.Name,
+			"location":             cluster.Region,
+			"endpoint":             endpoint,
+			"status":               cluster.status(gkeRunningStatus),
+			"selfLink":             fmt.Sprintf("%s/v1/projects/%s/locations/%s/clusters/%s", g.server.URL, g.projectID, cluster.Region, cluster.Name),
+			"currentMasterVersion": cluster.Version,
+			"masterAuth": map[string]string{
+				"clusterCaCertificate": base64.StdEncoding.EncodeToString(cluster.caData()),
+			},
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"clusters": clusters})
+}
+
+func writeGCPError(w http.ResponseWriter, code int, status string, message string) {
+	writeJSON(w, code, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+			"status":  status,
+		},
+	})
+}
+
+func oauthError(code string, description string) map[string]string {
+	return map[string]string{
+		"error":             code,
+		"error_description": description,
+	}
+}
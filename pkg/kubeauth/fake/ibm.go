@@ -0,0 +1,284 @@
+package fake
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	ibmNormalState       = "normal"
+	ibmAPIKeyGrantType   = "urn:ibm:params:oauth:grant-type:apikey"
+	ibmRefreshGrantType  = "refresh_token"
+	ibmResourceGroupHdr  = "X-Auth-Resource-Group"
+	ibmTokenLifetime     = time.Hour
+	ibmConfigDirPrefix   = "kubeConfig"
+	ibmAdminCertFileName = "admin.pem"
+	ibmAdminKeyFileName  = "admin-key.pem"
+)
+
+// IBM is a fake of the IBM Cloud IAM token endpoint and of the IBM Cloud
+// Kubernetes Service endpoints used for listing the classic and VPC
+// clusters and for downloading their admin kubeconfigs
+type IBM struct {
+	server *httptest.Server
+	apiKey string
+
+	lock     sync.Mutex
+	clusters []*Cluster
+	tokens   map[string]bool
+	logins   int
+}
+
+// NewIBM starts a fake IBM Cloud which accepts the given API key and serves
+// the given clusters. It should be closed once it is no longer needed
+func NewIBM(apiKey string, clusters ...*Cluster) *IBM {
+	i := &IBM{
+		apiKey:   apiKey,
+		clusters: clusters,
+		tokens:   make(map[string]bool),
+	}
+	i.server = httptest.NewServer(http.HandlerFunc(i.serveHTTP))
+	return i
+}
+
+// URL returns the endpoint to be set as both the container and the IAM
+// endpoints through ibm.SetEndpoints
+func (i *IBM) URL() string {
+	return i.server.URL
+}
+
+// Close shuts down the fake
+func (i *IBM) Close() {
+	i.server.Close()
+}
+
+// AddCluster adds a cluster to the fake
+func (i *IBM) AddCluster(cluster *Cluster) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.clusters = append(i.clusters, cluster)
+}
+
+// Logins returns the number of API key logins served by the fake
+func (i *IBM) Logins() int {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	return i.logins
+}
+
+func (i *IBM) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/identity/token" {
+		i.issueToken(w, r)
+		return
+	}
+	if !i.authorized(r) {
+		writeJSON(w, http.StatusUnauthorized, ibmError("E0003", "Unauthorized"))
+		return
+	}
+	path := strings.Trim(r.URL.Path, "/")
+	switch {
+	case path == "v1/clusters":
+		i.listClusters(w, r, false)
+	case path == "v2/vpc/getClusters":
+		i.listClusters(w, r, true)
+	case path == "v2/satellite/getClusters":
+		writeJSON(w, http.StatusOK, []interface{}{})
+	case path == "v2/getCluster":
+		i.getCluster(w, r, r.URL.Query().Get("cluster"))
+	case strings.HasPrefix(path, "v1/clusters/") && strings.HasSuffix(path, "/config/admin"):
+		i.getClusterConfig(w, r, strings.TrimSuffix(strings.TrimPrefix(path, "v1/clusters/"), "/config/admin"))
+	default:
+		writeJSON(w, http.StatusNotFound, ibmError("E0001", "unsupported path "+r.URL.Path))
+	}
+}
+
+func (i *IBM) issueToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, iamError("BXNIM0109E", err.Error()))
+		return
+	}
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	switch r.PostForm.Get("grant_type") {
+	case ibmAPIKeyGrantType:
+		if r.PostForm.Get("apikey") != i.apiKey {
+			writeJSON(w, http.StatusBadRequest, iamError("BXNIM0415E", "Provided API key could not be found."))
+			return
+		}
+		i.logins++
+	case ibmRefreshGrantType:
+		if r.PostForm.Get("refresh_token") == "" {
+			writeJSON(w, http.StatusBadRequest, iamError("BXNIM0407E", "Provided refresh token is invalid."))
+			return
+		}
+	default:
+		writeJSON(w, http.StatusBadRequest, iamError("BXNIM0109E", "Unsupported grant type."))
+		return
+	}
+	token := newToken("iam-fake", ibmTokenLifetime)
+	i.tokens[token] = true
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token":  token,
+		"refresh_token": "fake-refresh-token",
+		"token_type":    "Bearer",
+		"expires_in":    int64(ibmTokenLifetime / time.Second),
+		"expiration":    time.Now().Add(ibmTokenLifetime).Unix(),
+	})
+}
+
+func (i *IBM) authorized(r *http.Request) bool {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	return i.tokens[bearerToken(r)]
+}
+
+func (i *IBM) listClusters(w http.ResponseWriter, r *http.Request, vpc bool) {
+	resourceGroup := r.Header.Get(ibmResourceGroupHdr)
+	clusters := make([]interface{}, 0)
+	for _, cluster := range i.getClusters() {
+		if cluster.VPC != vpc {
+			continue
+		}
+		if resourceGroup != "" && cluster.ResourceGroup != resourceGroup {
+			continue
+		}
+		clusters = append(clusters, ibmClusterInfo(cluster))
+	}
+	writeJSON(w, http.StatusOK, clusters)
+}
+
+func (i *IBM) getCluster(w http.ResponseWriter, r *http.Request, name string) {
+	cluster := i.findCluster(name, r.Header.Get(ibmResourceGroupHdr))
+	if cluster == nil {
+		writeJSON(w, http.StatusNotFound, ibmError("G0004", "The specified cluster could not be found."))
+		return
+	}
+	writeJSON(w, http.StatusOK, ibmClusterInfo(cluster))
+}
+
+func (i *IBM) getClusterConfig(w http.ResponseWriter, r *http.Request, name string) {
+	cluster := i.findCluster(name, r.Header.Get(ibmResourceGroupHdr))
+	if cluster == nil {
+		writeJSON(w, http.StatusNotFound, ibmError("G0004", "The specified cluster could not be found."))
+		return
+	}
+	archive, err := ibmConfigArchive(cluster)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, ibmError("E0002", err.Error()))
+		return
+	}
+	w.Header().Set("Content-Type", "application/zip")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(archive)
+}
+
+func (i *IBM) getClusters() []*Cluster {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	return append([]*Cluster(nil), i.clusters...)
+}
+
+// findCluster returns the cluster with the given name or ID in the
+// resource group, or in any resource group if it is empty
+func (i *IBM) findCluster(name string, resourceGroup string) *Cluster {
+	for _, cluster := range i.getClusters() {
+		if resourceGroup != "" && cluster.ResourceGroup != resourceGroup {
+			continue
+		}
+		if cluster.Name == name || cluster.id() == name {
+			return cluster
+		}
+	}
+	return nil
+}
+
+func ibmClusterInfo(cluster *Cluster) map[string]interface{} {
+	info := map[string]interface{}{
+		"id":                cluster.id(),
+		"name":              cluster.Name,
+		"region":            cluster.Region,
+		"resourceGroup":     cluster.ResourceGroup,
+		"masterKubeVersion": cluster.Version,
+		"state":             cluster.status(ibmNormalState),
+		"masterURL":         cluster.server(),
+	}
+	// The server url is blank for the VPC clusters
+	if !cluster.VPC {
+		info["serverURL"] = cluster.server()
+	}
+	return info
+}
+
+// ibmConfigArchive returns the config archive of the cluster in the layout
+// used by IBM, where the kubeconfig refers to the certificates by file name
+func ibmConfigArchive(cluster *Cluster) ([]byte, error) {
+	contextName := cluster.Name + "/" + cluster.id()
+	caFileName := fmt.Sprintf("ca-%s-%s.pem", cluster.Region, cluster.Name)
+	kubeconfig := fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: %[1]s
+clusters:
+- name: %[1]s
+  cluster:
+    certificate-authority: %[2]s
+    server: %[3]s
+contexts:
+- name: %[1]s
+  context:
+    cluster: %[1]s
+    user: admin
+users:
+- name: admin
+  user:
+    client-certificate: %[4]s
+    client-key: %[5]s
+`, contextName, caFileName, cluster.server(), ibmAdminCertFileName, ibmAdminKeyFileName)
+
+	clientCert := defaultCert()
+	dir := ibmConfigDirPrefix + "-" + cluster.id() + "/"
+	files := []struct {
+		name    string
+		content []byte
+	}{
+		{dir + "kube-config-" + cluster.Region + "-" + cluster.Name + ".yml", []byte(kubeconfig)},
+		{dir + caFileName, cluster.caData()},
+		{dir + ibmAdminCertFileName, clientCert.certPEM},
+		{dir + ibmAdminKeyFileName, clientCert.keyPEM},
+	}
+	var b bytes.Buffer
+	zipWriter := zip.NewWriter(&b)
+	for _, file := range files {
+		f, err := zipWriter.Create(file.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := f.Write(file.content); err != nil {
+			return nil, err
+		}
+	}
+	if err := zipWriter.Close(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func ibmError(code string, description string) map[string]interface{} {
+	return map[string]interface{}{
+		"code":        code,
+		"description": description,
+		"type":        "General",
+	}
+}
+
+func iamError(code string, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"errorCode":    code,
+		"errorMessage": message,
+	}
+}
@@ -0,0 +1,152 @@
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+)
+
+const (
+	oidcDiscoveryPath     = "/.well-known/openid-configuration"
+	oidcTokenPath         = "/token"
+	oidcTokenLifetime     = time.Hour
+	oidcClientCredentials = "client_credentials"
+	oidcRefreshToken      = "refresh_token"
+)
+
+// OIDC is a fake OpenID provider over TLS serving the discovery document
+// and the token endpoint, for the client credentials and the refresh token
+// grants. If rotation is enabled the refresh tokens are rotated on every
+// use, and the rotated refresh tokens are revoked
+type OIDC struct {
+	server       *httptest.Server
+	clientID     string
+	clientSecret string
+
+	lock          sync.Mutex
+	refreshTokens map[string]bool
+	rotate        bool
+	tokenLifetime time.Duration
+	rotated       int
+	issued        int
+}
+
+// NewOIDC starts a fake OpenID provider which accepts the given client and
+// refresh token. It should be closed once it is no longer needed
+func NewOIDC(clientID string, clientSecret string, refreshToken string) *OIDC {
+	o := &OIDC{
+		clientID:      clientID,
+		clientSecret:  clientSecret,
+		refreshTokens: map[string]bool{refreshToken: true},
+		tokenLifetime: oidcTokenLifetime,
+	}
+	o.server = httptest.NewTLSServer(http.HandlerFunc(o.serveHTTP))
+	return o
+}
+
+// URL returns the issuer URL of the provider
+func (o *OIDC) URL() string {
+	return o.server.URL
+}
+
+// Close shuts down the fake
+func (o *OIDC) Close() {
+	o.server.Close()
+}
+
+// CAData returns the PEM encoded CA certificate of the provider
+func (o *OIDC) CAData() []byte {
+	return pemCertificate(o.server.Certificate().Raw)
+}
+
+// SetRotateRefreshTokens sets whether a new refresh token is returned
+// along with every token issued through the refresh token grant
+func (o *OIDC) SetRotateRefreshTokens(rotate bool) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	o.rotate = rotate
+}
+
+// SetTokenLifetime sets the lifetime of the tokens issued by the provider
+func (o *OIDC) SetTokenLifetime(lifetime time.Duration) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	o.tokenLifetime = lifetime
+}
+
+// IssuedTokens returns the number of ID tokens issued by the provider
+func (o *OIDC) IssuedTokens() int {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	return o.issued
+}
+
+func (o *OIDC) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case oidcDiscoveryPath:
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"issuer":                                o.server.URL,
+			"token_endpoint":                        o.server.URL + oidcTokenPath,
+			"grant_types_supported":                 []string{oidcClientCredentials, oidcRefreshToken},
+			"id_token_signing_alg_values_supported": []string{"none"},
+		})
+	case oidcTokenPath:
+		o.issueToken(w, r)
+	default:
+		writeJSON(w, http.StatusNotFound, oauthError("not_found", "unsupported path "+r.URL.Path))
+	}
+}
+
+func (o *OIDC) issueToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.Method != http.MethodPost {
+		writeJSON(w, http.StatusBadRequest, oauthError("invalid_request", "unsupported request"))
+		return
+	}
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	grantType := r.PostForm.Get("grant_type")
+	// Public clients can use the refresh token grant without a secret
+	secretValid := clientSecret == o.clientSecret || (grantType == oidcRefreshToken && clientSecret == "")
+	if clientID != o.clientID || !secretValid {
+		writeJSON(w, http.StatusUnauthorized, oauthError("invalid_client", "Invalid client credentials"))
+		return
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	resp := map[string]interface{}{
+		"token_type": "Bearer",
+		"expires_in": int64(o.tokenLifetime / time.Second),
+	}
+	switch grantType {
+	case oidcClientCredentials:
+		if clientSecret == "" {
+			writeJSON(w, http.StatusUnauthorized, oauthError("invalid_client", "Invalid client credentials"))
+			return
+		}
+	case oidcRefreshToken:
+		refreshToken := r.PostForm.Get("refresh_token")
+		if !o.refreshTokens[refreshToken] {
+			writeJSON(w, http.StatusBadRequest, oauthError("invalid_grant", "Invalid refresh token"))
+			return
+		}
+		if o.rotate {
+			delete(o.refreshTokens, refreshToken)
+			o.rotated++
+			rotated := fmt.Sprintf("rotated-refresh-token-%d", o.rotated)
+			o.refreshTokens[rotated] = true
+			resp["refresh_token"] = rotated
+		}
+	default:
+		writeJSON(w, http.StatusBadRequest, oauthError("unsupported_grant_type", "Unsupported grant type "+grantType))
+		return
+	}
+	o.issued++
+	resp["access_token"] = newToken(clientID, o.tokenLifetime)
+	resp["id_token"] = newToken(clientID, o.tokenLifetime)
+	writeJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,178 @@
+package fake
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	rancherActiveState    = "active"
+	rancherAPIPath        = "/v3"
+	rancherClusterProxy   = "/k8s/clusters/"
+	rancherDefaultMaxSize = 1000
+)
+
+// Rancher is a fake of the Rancher v3 API used for listing the downstream
+// clusters and for fetching the CA certificates of the server, and of the
+// Rancher cluster proxy. The proxy serves the version endpoint of the
+// downstream clusters itself, with the version of the cluster
+type Rancher struct {
+	server *httptest.Server
+	token  string
+
+	lock     sync.Mutex
+	clusters []*Cluster
+	// reportCACerts is false if the cacerts setting is empty, as for
+	// the servers using certificates signed by a public CA
+	reportCACerts bool
+}
+
+// NewRancher starts a fake Rancher server over TLS which accepts the given
+// token and serves the given clusters. It should be closed once it is no
+// longer needed
+func NewRancher(token string, clusters ...*Cluster) *Rancher {
+	r := &Rancher{
+		token:         token,
+		clusters:      clusters,
+		reportCACerts: true,
+	}
+	r.server = httptest.NewTLSServer(http.HandlerFunc(r.serveHTTP))
+	return r
+}
+
+// URL returns the endpoint of the Rancher server
+func (r *Rancher) URL() string {
+	return r.server.URL
+}
+
+// Close shuts down the fake
+func (r *Rancher) Close() {
+	r.server.Close()
+}
+
+// CAData returns the PEM encoded CA certificate of the Rancher server
+func (r *Rancher) CAData() []byte {
+	return pemCertificate(r.server.Certificate().Raw)
+}
+
+// SetReportCACerts sets whether the CA certificate of the server is
+// returned by the cacerts setting
+func (r *Rancher) SetReportCACerts(report bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.reportCACerts = report
+}
+
+func (r *Rancher) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	if bearerToken(req) != r.token {
+		writeJSON(w, http.StatusUnauthorized, rancherError(http.StatusUnauthorized, "Unauthorized", "must authenticate"))
+		return
+	}
+	path := req.URL.Path
+	switch {
+	case path == rancherAPIPath+"/clusters":
+		r.listClusters(w, req)
+	case path == rancherAPIPath+"/settings/cacerts":
+		r.getCACerts(w)
+	case strings.HasPrefix(path, rancherClusterProxy):
+		r.proxy(w, strings.TrimPrefix(path, rancherClusterProxy))
+	default:
+		writeJSON(w, http.StatusNotFound, rancherError(http.StatusNotFound, "NotFound", "unsupported path "+path))
+	}
+}
+
+func (r *Rancher) listClusters(w http.ResponseWriter, req *http.Request) {
+	name := req.URL.Query().Get("name")
+	r.lock.Lock()
+	clusters := make([]*Cluster, 0)
+	for _, cluster := range r.clusters {
+		if name == "" || cluster.Name == name {
+			clusters = append(clusters, cluster)
+		}
+	}
+	r.lock.Unlock()
+	sort.Slice(clusters, func(i, j int) bool { return clusters[i].id() < clusters[j].id() })
+
+	limit := rancherDefaultMaxSize
+	if value := req.URL.Query().Get("limit"); value != "" {
+		var err error
+		if limit, err = strconv.Atoi(value); err != nil || limit < 1 {
+			writeJSON(w, http.StatusUnprocessableEntity, rancherError(http.StatusUnprocessableEntity, "InvalidFormat", "invalid limit "+value))
+			return
+		}
+	}
+	// The marker is the ID of the first cluster of the page
+	offset := 0
+	if marker := req.URL.Query().Get("marker"); marker != "" {
+		offset = sort.Search(len(clusters), func(i int) bool { return clusters[i].id() >= marker })
+	}
+	end := len(clusters)
+	pagination := map[string]interface{}{"limit": limit}
+	if offset+limit < len(clusters) {
+		end = offset + limit
+		query := req.URL.Query()
+		query.Set("marker", clusters[end].id())
+		pagination["next"] = r.server.URL + req.URL.Path + "?" + query.Encode()
+	}
+	data := make([]interface{}, 0, end-offset)
+	for _, cluster := range clusters[offset:end] {
+		data = append(data, map[string]interface{}{
+			"id":      cluster.id(),
+			"name":    cluster.Name,
+			"state":   cluster.status(rancherActiveState),
+			"version": map[string]string{"gitVersion": cluster.Version},
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"type":       "collection",
+		"data":       data,
+		"pagination": pagination,
+	})
+}
+
+func (r *Rancher) getCACerts(w http.ResponseWriter) {
+	r.lock.Lock()
+	report := r.reportCACerts
+	r.lock.Unlock()
+	value := ""
+	if report {
+		value = string(r.CAData())
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"id": "cacerts", "value": value})
+}
+
+// proxy serves the version endpoint of the downstream cluster
+func (r *Rancher) proxy(w http.ResponseWriter, path string) {
+	tokens := strings.SplitN(path, "/", 2)
+	r.lock.Lock()
+	var found *Cluster
+	for _, cluster := range r.clusters {
+		if cluster.id() == tokens[0] {
+			found = cluster
+			break
+		}
+	}
+	r.lock.Unlock()
+	if found == nil || found.status(rancherActiveState) != rancherActiveState {
+		writeJSON(w, http.StatusServiceUnavailable, rancherError(http.StatusServiceUnavailable, "ClusterUnavailable", "cluster not available"))
+		return
+	}
+	if len(tokens) != 2 || tokens[1] != "version" {
+		writeJSON(w, http.StatusNotFound, map[string]string{"kind": "Status", "status": "Failure", "reason": "NotFound"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"gitVersion": found.Version})
+}
+
+func rancherError(status int, code string, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":    "error",
+		"status":  status,
+		"code":    code,
+		"message": message,
+	}
+}
@@ -0,0 +1,150 @@
+package fake
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	stsNamespace           = "https://sts.amazonaws.com/doc/2011-06-15/"
+	stsDefaultDuration     = time.Hour
+	stsAssumeRoleAction    = "AssumeRole"
+	stsAssumedAccessPrefix = "ASIAFAKE"
+)
+
+// STS is a fake of the STS AssumeRole API. It only lets the given roles be
+// assumed, with the external ID if one is required for the role
+type STS struct {
+	server *httptest.Server
+
+	lock    sync.Mutex
+	roles   map[string]string
+	assumed int
+}
+
+// NewSTS starts a fake STS which lets the roles be assumed. The roles map
+// the role ARNs to their required external ID, which is empty if none is
+// required. It should be closed once it is no longer needed
+func NewSTS(roles map[string]string) *STS {
+	s := &STS{roles: make(map[string]string)}
+	for roleArn, externalID := range roles {
+		s.roles[roleArn] = externalID
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// URL returns the endpoint to be set through aws.SetSTSEndpoint
+func (s *STS) URL() string {
+	return s.server.URL
+}
+
+// Close shuts down the fake
+func (s *STS) Close() {
+	s.server.Close()
+}
+
+// AssumedRoles returns the number of roles assumed through the fake
+func (s *STS) AssumedRoles() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.assumed
+}
+
+type stsCredentials struct {
+	AccessKeyID     string `xml:"AccessKeyId"`
+	SecretAccessKey string `xml:"SecretAccessKey"`
+	SessionToken    string `xml:"SessionToken"`
+	Expiration      string `xml:"Expiration"`
+}
+
+type stsAssumeRoleResponse struct {
+	XMLName xml.Name `xml:"AssumeRoleResponse"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	Result  struct {
+		Credentials     stsCredentials `xml:"Credentials"`
+		AssumedRoleUser struct {
+			Arn           string `xml:"Arn"`
+			AssumedRoleID string `xml:"AssumedRoleId"`
+		} `xml:"AssumedRoleUser"`
+	} `xml:"AssumeRoleResult"`
+	RequestID string `xml:"ResponseMetadata>RequestId"`
+}
+
+type stsErrorResponse struct {
+	XMLName xml.Name `xml:"ErrorResponse"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	Error   struct {
+		Type    string `xml:"Type"`
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	} `xml:"Error"`
+	RequestID string `xml:"RequestId"`
+}
+
+func (s *STS) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if signingRegion(r) == "" {
+		writeSTSError(w, http.StatusForbidden, "MissingAuthenticationToken", "request is not signed")
+		return
+	}
+	if err := r.ParseForm(); err != nil || r.Method != http.MethodPost {
+		writeSTSError(w, http.StatusBadRequest, "InvalidAction", "unsupported request")
+		return
+	}
+	if action := r.PostForm.Get("Action"); action != stsAssumeRoleAction {
+		writeSTSError(w, http.StatusBadRequest, "InvalidAction", "unsupported action "+action)
+		return
+	}
+	roleArn := r.PostForm.Get("RoleArn")
+	s.lock.Lock()
+	externalID, ok := s.roles[roleArn]
+	s.lock.Unlock()
+	if !ok || externalID != r.PostForm.Get("ExternalId") {
+		writeSTSError(w, http.StatusForbidden, "AccessDenied",
+			fmt.Sprintf("User is not authorized to perform: sts:AssumeRole on resource: %v", roleArn))
+		return
+	}
+	duration := stsDefaultDuration
+	if value := r.PostForm.Get("DurationSeconds"); value != "" {
+		seconds, err := strconv.Atoi(value)
+		if err != nil || seconds < 900 {
+			writeSTSError(w, http.StatusBadRequest, "ValidationError", "invalid DurationSeconds "+value)
+			return
+		}
+		duration = time.Duration(seconds) * time.Second
+	}
+
+	s.lock.Lock()
+	s.assumed++
+	count := s.assumed
+	s.lock.Unlock()
+	resp := &stsAssumeRoleResponse{Xmlns: stsNamespace, RequestID: strconv.Itoa(count)}
+	resp.Result.Credentials = stsCredentials{
+		AccessKeyID:     fmt.Sprintf("%s%08d", stsAssumedAccessPrefix, count),
+		SecretAccessKey: "fake-secret",
+		SessionToken:    newToken(roleArn, duration),
+		Expiration:      time.Now().Add(duration).UTC().Format(time.RFC3339),
+	}
+	resp.Result.AssumedRoleUser.Arn = roleArn + "/" + r.PostForm.Get("RoleSessionName")
+	resp.Result.AssumedRoleUser.AssumedRoleID = "AROAFAKE:" + r.PostForm.Get("RoleSessionName")
+	writeXML(w, http.StatusOK, resp)
+}
+
+func writeSTSError(w http.ResponseWriter, status int, code string, message string) {
+	resp := &stsErrorResponse{Xmlns: stsNamespace, RequestID: "fake"}
+	resp.Error.Type = "Sender"
+	resp.Error.Code = code
+	resp.Error.Message = message
+	writeXML(w, status, resp)
+}
+
+func writeXML(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "text/xml")
+	w.WriteHeader(status)
+	_ = xml.NewEncoder(w).Encode(body)
+}
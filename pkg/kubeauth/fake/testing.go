@@ -0,0 +1,132 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+	"github.com/portworx/px-backup-api/pkg/kubeauth"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// NewCloudCredential returns a cloud credential with the config of one of
+// the providers, like an *api.AWSConfig
+func NewCloudCredential(config interface{}) *api.CloudCredentialObject {
+	info := &api.CloudCredentialInfo{}
+	switch config := config.(type) {
+	case *api.AWSConfig:
+		info.Type = api.CloudCredentialInfo_AWS
+		info.Config = &api.CloudCredentialInfo_AwsConfig{AwsConfig: config}
+	case *api.AzureConfig:
+		info.Type = api.CloudCredentialInfo_Azure
+		info.Config = &api.CloudCredentialInfo_AzureConfig{AzureConfig: config}
+	case *api.GoogleConfig:
+		info.Type = api.CloudCredentialInfo_Google
+		info.Config = &api.CloudCredentialInfo_GoogleConfig{GoogleConfig: config}
+	case *api.IBMConfig:
+		info.Type = api.CloudCredentialInfo_IBM
+		info.Config = &api.CloudCredentialInfo_IbmConfig{IbmConfig: config}
+	case *api.RancherConfig:
+		info.Type = api.CloudCredentialInfo_Rancher
+		info.Config = &api.CloudCredentialInfo_RancherConfig{RancherConfig: config}
+	case *api.OIDCConfig:
+		info.Type = api.CloudCredentialInfo_OIDC
+		info.Config = &api.CloudCredentialInfo_OidcConfig{OidcConfig: config}
+	default:
+		panic(fmt.Sprintf("unsupported cloud credential config %T", config))
+	}
+	return &api.CloudCredentialObject{CloudCredentialInfo: info}
+}
+
+// Page is a page of clusters returned by kubeauth.GetAllClientsWithSkipped
+type Page struct {
+	Clients   map[string]*kubeauth.PluginClient
+	Skipped   map[string]*kubeauth.SkippedCluster
+	NextToken string
+}
+
+// AllClients pages through the clusters of the cloud credential with the
+// given page size and returns the pages. The next tokens are set in the
+// enumerate config, which is one of the ManagedClusterEnumerateRequest
+// configs. It fails the test if a page is larger than the page size
+func AllClients(t testing.TB, cloudCred *api.CloudCredentialObject, pageSize int64, config interface{}) []*Page {
+	t.Helper()
+	pages := make([]*Page, 0)
+	for {
+		clients, skipped, nextToken, err := kubeauth.GetAllClientsWithSkipped(context.Background(), cloudCred, pageSize, config)
+		if err != nil {
+			t.Fatalf("GetAllClientsWithSkipped failed on page %v: %v", len(pages), err)
+		}
+		if pageSize != 0 && int64(len(clients)) > pageSize {
+			t.Errorf("expected at most %v clients on page %v, got %v", pageSize, len(pages), len(clients))
+		}
+		page := &Page{Clients: clients, Skipped: skipped}
+		pages = append(pages, page)
+		if nextToken == nil {
+			return pages
+		}
+		page.NextToken = *nextToken
+		setNextToken(t, config, *nextToken)
+	}
+}
+
+// Merge returns the clients and the skipped clusters of all the pages
+func Merge(pages []*Page) (map[string]*kubeauth.PluginClient, map[string]*kubeauth.SkippedCluster) {
+	clients := make(map[string]*kubeauth.PluginClient)
+	skipped := make(map[string]*kubeauth.SkippedCluster)
+	for _, page := range pages {
+		for key, client := range page.Clients {
+			clients[key] = client
+		}
+		for key, cluster := range page.Skipped {
+			skipped[key] = cluster
+		}
+	}
+	return clients, skipped
+}
+
+func setNextToken(t testing.TB, config interface{}, nextToken string) {
+	switch config := config.(type) {
+	case *api.ManagedClusterEnumerateRequest_AWSConfig:
+		config.NextToken = nextToken
+	case *api.ManagedClusterEnumerateRequest_AzureConfig:
+		config.NextToken = nextToken
+	case *api.ManagedClusterEnumerateRequest_GoogleConfig:
+		config.NextToken = nextToken
+	case *api.ManagedClusterEnumerateRequest_IBMConfig:
+		config.NextToken = nextToken
+	case *api.ManagedClusterEnumerateRequest_RancherConfig:
+		config.NextToken = nextToken
+	default:
+		t.Fatalf("unsupported enumerate config %T", config)
+	}
+}
+
+// ServerVersion returns the git version served to the rest config
+func ServerVersion(t testing.TB, restConfig *rest.Config) string {
+	t.Helper()
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		t.Fatalf("failed to create the clientset: %v", err)
+	}
+	version, err := clientset.Discovery().ServerVersion()
+	if err != nil {
+		t.Fatalf("failed to get the server version: %v", err)
+	}
+	return version.GitVersion
+}
+
+// CheckClient verifies that the rest config reaches the API server. The
+// credentials the requests carry are up to the plugin tests to verify,
+// for example through the LastToken of the API server
+func CheckClient(t testing.TB, restConfig *rest.Config, server *APIServer) {
+	t.Helper()
+	if restConfig.Host != server.URL {
+		t.Errorf("expected the client of %v, got %v", server.URL, restConfig.Host)
+	}
+	if version := ServerVersion(t, restConfig); version != server.Version {
+		t.Errorf("expected server version %v, got %v", server.Version, version)
+	}
+}
@@ -2,8 +2,16 @@ package gcp
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 
 	api "github.com/portworx/px-backup-api/pkg/apis/v1"
 	"github.com/portworx/px-backup-api/pkg/kubeauth"
@@ -20,6 +28,10 @@ const (
 	credOrgIDConfig = "cred-org-id"
 	credOwnerConfig = "cred-owner"
 	credJSON        = "cred-json"
+	// allLocations is the GKE wildcard for listing clusters in all the
+	// zones and regions of a project
+	allLocations             = "-"
+	defaultContainerEndpoint = "https://container.googleapis.com"
 )
 
 var (
@@ -29,11 +41,36 @@ var (
 	}
 )
 
+var (
+	endpointLock sync.RWMutex
+	// containerEndpoint is the GKE API endpoint
+	containerEndpoint = defaultContainerEndpoint
+)
+
 type gcp struct {
 }
 
 type gcpToken struct {
-	tokenSource oauth2.TokenSource
+	tokenSource *kubeauth.CachedTokenSource
+}
+
+// gkeCluster is the subset of the GKE Cluster resource used by this plugin
+type gkeCluster struct {
+	ID                   string `json:"id"`
+	Name                 string `json:"name"`
+	Location             string `json:"location"`
+	Endpoint             string `json:"endpoint"`
+	Status               string `json:"status"`
+	SelfLink             string `json:"selfLink"`
+	CurrentMasterVersion string `json:"currentMasterVersion"`
+	MasterAuth           struct {
+		ClusterCaCertificate string `json:"clusterCaCertificate"`
+	} `json:"masterAuth"`
+}
+
+type gkeClusterList struct {
+	Clusters     []*gkeCluster `json:"clusters"`
+	MissingZones []string      `json:"missingZones"`
 }
 
 // Init initializes the gcp auth plugin
@@ -42,8 +79,8 @@ func (g *gcp) Init() error {
 }
 
 func (g *gcp) UpdateClient(
-	conn *grpc.ClientConn,
 	ctx context.Context,
+	conn *grpc.ClientConn,
 	cloudCredentialName string,
 	cloudCredentialUID string,
 	orgID string,
@@ -86,21 +123,21 @@ func (g *gcp) newGCPAuthProvider(
 	gcpConfig map[string]string,
 	_ rest.AuthProviderConfigPersister,
 ) (rest.AuthProvider, error) {
-	var creds *google.Credentials
-	var err error
-	if creds, err = google.CredentialsFromJSON(context.Background(), []byte(gcpConfig[credJSON]), defaultScopes...); err != nil {
+	tokenSource, err := getClientTokenSource(gcpConfig[credJSON])
+	if err != nil {
 		return nil, err
 	}
-	return &gcpToken{tokenSource: creds.TokenSource}, nil
+	return &gcpToken{tokenSource: tokenSource}, nil
 }
 
 func (g *gcpToken) Login() error { return nil }
 
 func (g *gcpToken) WrapTransport(rt http.RoundTripper) http.RoundTripper {
-	return &oauth2.Transport{Source: g.tokenSource, Base: rt}
+	return g.tokenSource.WrapTransport()(rt)
 }
 
 func (g *gcp) UpdateClientByCredObject(
+	_ context.Context,
 	cloudCred *api.CloudCredentialObject,
 	client *rest.Config,
 	clientConfig *clientcmd.Config,
@@ -123,20 +160,351 @@ func (g *gcp) UpdateClientByCredObject(
 }
 
 func (g *gcp) GetClient(
+	ctx context.Context,
 	cloudCredential *api.CloudCredentialObject,
 	clusterName string,
 	region string,
 ) (*kubeauth.PluginClient, error) {
-	return nil, fmt.Errorf("not supported")
+	googleConfig := cloudCredential.GetCloudCredentialInfo().GetGoogleConfig()
+	if googleConfig == nil {
+		return nil, fmt.Errorf("cloud credentials are not for google")
+	}
+	return GetRestConfigForCluster(ctx, clusterName, googleConfig, region)
 }
 
 func (g *gcp) GetAllClients(
+	ctx context.Context,
 	cloudCredential *api.CloudCredentialObject,
 	maxResult int64,
 	config interface{},
 ) (map[string]*kubeauth.PluginClient, *string, error) {
-	return nil, nil, fmt.Errorf("not supported")
+	googleConfig := cloudCredential.GetCloudCredentialInfo().GetGoogleConfig()
+	if googleConfig == nil {
+		return nil, nil, fmt.Errorf("cloud credentials are not for google")
+	}
+	return GetRestConfigForAllClusters(ctx, googleConfig, maxResult, config)
+}
+
+// ClusterName returns the cluster name to be passed to GetClient for the
+// GKE cluster with the given name, location and project. The project of
+// the cloud credential is used if projectID is empty, and all locations
+// are searched if location is empty
+func ClusterName(projectID string, location string, name string) string {
+	if projectID != "" {
+		return projectID + "/" + location + "/" + name
+	}
+	if location != "" {
+		return location + "/" + name
+	}
+	return name
+}
+
+// GetRestConfigForCluster returns the client for the GKE cluster with the
+// given name. The location can be a region or a zone. The cluster name can
+// be prefixed with its location, "<location>/<cluster-name>", or with its
+// project and location, "<project>/<location>/<cluster-name>", as built
+// by ClusterName. The project of the cloud credential is used if the name
+// has no project. If location is empty all locations are searched and an
+// error is returned if clusters with the name exist in several of them
+func GetRestConfigForCluster(
+	ctx context.Context,
+	clusterName string,
+	googleConfig *api.GoogleConfig,
+	location string,
+) (*kubeauth.PluginClient, error) {
+	creds, err := getCredentials(ctx, googleConfig)
+	if err != nil {
+		return nil, err
+	}
+	var projectID string
+	switch tokens := strings.SplitN(clusterName, "/", 3); len(tokens) {
+	case 3:
+		projectID, location, clusterName = tokens[0], tokens[1], tokens[2]
+	case 2:
+		location, clusterName = tokens[0], tokens[1]
+	}
+	projectID = getProjectID(projectID, googleConfig, creds)
+	if projectID == "" {
+		return nil, fmt.Errorf("project not provided for GKE cluster")
+	}
+	httpClient := oauth2.NewClient(ctx, creds.TokenSource)
+	if location == "" {
+		location = allLocations
+	}
+	clusters, err := listClusters(ctx, httpClient, projectID, location)
+	if err != nil {
+		return nil, err
+	}
+	matches := make([]*gkeCluster, 0)
+	for _, cluster := range clusters {
+		if cluster.Name == clusterName {
+			matches = append(matches, cluster)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("GKE cluster %v not found in project %v", clusterName, projectID)
+	case 1:
+		tokenSource, err := getClientTokenSource(googleConfig.GetJsonKey())
+		if err != nil {
+			return nil, err
+		}
+		return getPluginClient(matches[0], tokenSource)
+	}
+	keys := make([]string, 0, len(matches))
+	for _, cluster := range matches {
+		keys = append(keys, clusterKey(cluster))
+	}
+	return nil, fmt.Errorf("GKE cluster name %v is ambiguous in project %v, it matches %v. Provide its location",
+		clusterName, projectID, strings.Join(keys, ", "))
+}
+
+// GetRestConfigForAllClusters returns the clients for all the GKE clusters
+// in the requested project and locations, keyed by
+// "<location>/<cluster-name>". GKE returns all the clusters of a location
+// at once, hence the results are paginated across the locations using an
+// opaque token of the form "<location-index>:<offset>"
+func GetRestConfigForAllClusters(
+	ctx context.Context,
+	googleConfig *api.GoogleConfig,
+	maxResults int64,
+	config interface{},
+) (map[string]*kubeauth.PluginClient, *string, error) {
+	funct := "GetRestConfigForAllClusters"
+	googleCfg, ok := config.(*api.ManagedClusterEnumerateRequest_GoogleConfig)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid config %T provided for GKE cluster scan", config)
+	}
+	creds, err := getCredentials(ctx, googleConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	tokenSource, err := getClientTokenSource(googleConfig.GetJsonKey())
+	if err != nil {
+		return nil, nil, err
+	}
+	projectID := getProjectID(googleCfg.GetProjectId(), googleConfig, creds)
+	if projectID == "" {
+		return nil, nil, fmt.Errorf("project not provided for GKE cluster scan")
+	}
+	locations := googleCfg.GetLocations()
+	if len(locations) == 0 {
+		locations = []string{allLocations}
+	}
+	locationIdx, offset, err := parsePageToken(googleCfg.GetNextToken(), len(locations))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	httpClient := oauth2.NewClient(ctx, creds.TokenSource)
+	restConfigs := make(map[string]*kubeauth.PluginClient)
+	var nextToken *string
+	for ; locationIdx < len(locations); locationIdx, offset = locationIdx+1, 0 {
+		clusters, err := listClusters(ctx, httpClient, projectID, locations[locationIdx])
+		if err != nil {
+			return nil, nil, err
+		}
+		logrus.Tracef("%s: listed %v clusters in %v", funct, len(clusters), locations[locationIdx])
+		for ; offset < len(clusters); offset++ {
+			if maxResults != 0 && int64(len(restConfigs)) >= maxResults {
+				token := fmt.Sprintf("%d:%d", locationIdx, offset)
+				nextToken = &token
+				return restConfigs, nextToken, nil
+			}
+			cluster := clusters[offset]
+			pluginClient, err := getPluginClient(cluster, tokenSource)
+			// On error continue to next cluster as we don't want to stop the
+			// scan for one cluster error.
+			if err != nil {
+				kubeauth.ReportSkippedCluster(ctx, clusterKey(cluster), &kubeauth.SkippedCluster{
+					Uid:     cluster.ID,
+					Version: cluster.CurrentMasterVersion,
+					Region:  cluster.Location,
+					Reason:  err.Error(),
+				})
+				continue
+			}
+			restConfigs[clusterKey(cluster)] = pluginClient
+		}
+	}
+	return restConfigs, nextToken, nil
+}
+
+// clusterKey returns "<location>/<cluster-name>" for the cluster as the
+// cluster names are only unique within a location
+func clusterKey(cluster *gkeCluster) string {
+	return cluster.Location + "/" + cluster.Name
+}
+
+func getPluginClient(cluster *gkeCluster, tokenSource *kubeauth.CachedTokenSource) (*kubeauth.PluginClient, error) {
+	if cluster.Endpoint == "" {
+		return nil, fmt.Errorf("endpoint not available for cluster %v in status %v", cluster.Name, cluster.Status)
+	}
+	ca, err := base64.StdEncoding.DecodeString(cluster.MasterAuth.ClusterCaCertificate)
+	if err != nil {
+		return nil, err
+	}
+	host := "https://" + cluster.Endpoint
+	restConfig := &rest.Config{
+		Host: host,
+		TLSClientConfig: rest.TLSClientConfig{
+			CAData: ca,
+		},
+		WrapTransport: tokenSource.WrapTransport(),
+	}
+	return &kubeauth.PluginClient{
+		Kubeconfig: buildKubeconfig(host, cluster.Name, cluster.MasterAuth.ClusterCaCertificate),
+		Rest:       restConfig,
+		Uid:        cluster.ID,
+		Version:    cluster.CurrentMasterVersion,
+	}, nil
+}
+
+// SetEndpoint overrides the GKE API endpoint. It is meant for running the
+// plugin against a fake GKE. The OAuth2 tokens are fetched from the token_uri
+// of the json key. An empty endpoint restores the default endpoint
+func SetEndpoint(endpoint string) {
+	endpointLock.Lock()
+	defer endpointLock.Unlock()
+	if endpoint == "" {
+		endpoint = defaultContainerEndpoint
+	}
+	containerEndpoint = endpoint
+}
+
+func getContainerEndpoint() string {
+	endpointLock.RLock()
+	defer endpointLock.RUnlock()
+	return containerEndpoint
+}
+
+func listClusters(ctx context.Context, httpClient *http.Client, projectID string, location string) ([]*gkeCluster, error) {
+	listURL := fmt.Sprintf("%s/v1/projects/%s/locations/%s/clusters",
+		getContainerEndpoint(), url.PathEscape(projectID), url.PathEscape(location))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, listURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to list GKE clusters: %v: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	clusters := &gkeClusterList{}
+	if err := json.Unmarshal(body, clusters); err != nil {
+		return nil, err
+	}
+	if len(clusters.MissingZones) > 0 {
+		logrus.Warnf("GKE clusters could not be listed in zones %v", clusters.MissingZones)
+	}
+	// Keep the order stable across pages
+	sort.Slice(clusters.Clusters, func(i, j int) bool {
+		return clusters.Clusters[i].SelfLink < clusters.Clusters[j].SelfLink
+	})
+	return clusters.Clusters, nil
+}
+
+func parsePageToken(token string, numLocations int) (int, int, error) {
+	if token == "" {
+		return 0, 0, nil
+	}
+	tokens := strings.Split(token, ":")
+	if len(tokens) != 2 {
+		return 0, 0, fmt.Errorf("invalid next token %v provided for GKE cluster scan", token)
+	}
+	locationIdx, err := strconv.Atoi(tokens[0])
+	if err != nil || locationIdx < 0 || locationIdx >= numLocations {
+		return 0, 0, fmt.Errorf("invalid next token %v provided for GKE cluster scan", token)
+	}
+	offset, err := strconv.Atoi(tokens[1])
+	if err != nil || offset < 0 {
+		return 0, 0, fmt.Errorf("invalid next token %v provided for GKE cluster scan", token)
+	}
+	return locationIdx, offset, nil
+}
+
+// getCredentials returns the credentials from the cloud credential. The
+// tokens are fetched with the provided context, hence the credentials
+// should not be used once the context is done
+func getCredentials(ctx context.Context, googleConfig *api.GoogleConfig) (*google.Credentials, error) {
+	if googleConfig.GetJsonKey() == "" {
+		return nil, fmt.Errorf("json key not provided in the Google CloudCredential")
+	}
+	return google.CredentialsFromJSON(ctx, []byte(googleConfig.GetJsonKey()), defaultScopes...)
+}
+
+// getClientTokenSource returns the cached token source used by the
+// returned clients, which outlive the context of the request. The tokens
+// are cached per service account and shared by all its clusters
+func getClientTokenSource(jsonKey string) (*kubeauth.CachedTokenSource, error) {
+	if jsonKey == "" {
+		return nil, fmt.Errorf("json key not provided in the Google CloudCredential")
+	}
+	creds, err := google.CredentialsFromJSON(context.Background(), []byte(jsonKey), defaultScopes...)
+	if err != nil {
+		return nil, err
+	}
+	var key struct {
+		ClientEmail string `json:"client_email"`
+	}
+	identity := kubeauth.TokenFingerprint(jsonKey)
+	if err := json.Unmarshal([]byte(jsonKey), &key); err == nil && key.ClientEmail != "" {
+		identity = key.ClientEmail
+	}
+	return kubeauth.GetCachedTokenSource(
+		pluginName+"/"+identity,
+		kubeauth.TokenFingerprint(jsonKey),
+		kubeauth.OAuth2TokenGenerator(creds.TokenSource),
+	), nil
+}
 
+// getProjectID returns the first project found in the request, the cloud
+// credential and the json key, in that order
+func getProjectID(projectID string, googleConfig *api.GoogleConfig, creds *google.Credentials) string {
+	if projectID != "" {
+		return projectID
+	}
+	if googleConfig.GetProjectId() != "" {
+		return googleConfig.GetProjectId()
+	}
+	return creds.ProjectID
+}
+
+// buildKubeconfig returns a kubeconfig which uses the gcp auth provider
+// so that the tokens are generated from the cloud credential on UpdateClient
+func buildKubeconfig(
+	clusterEndpoint string,
+	clusterName string,
+	certData string,
+) string {
+	return fmt.Sprintf(`
+apiVersion: v1
+clusters:
+- cluster:
+    server: %s
+    certificate-authority-data: %s
+  name: %s
+contexts:
+- context:
+    cluster: %s
+    user: %s
+  name: %s
+current-context: %s
+kind: Config
+preferences: {}
+users:
+- name: %s
+  user:
+    auth-provider:
+      name: %s
+`, clusterEndpoint, certData, clusterName, clusterName, pluginName, clusterName, clusterName, pluginName, pluginName)
 }
 
 func init() {
@@ -144,7 +512,18 @@ func init() {
 	if err := rest.RegisterAuthProviderPlugin(pluginName, g.newGCPAuthProvider); err != nil {
 		logrus.Panicf("failed to initialize gcp auth plugin: %v", err)
 	}
-	if err := kubeauth.Register(pluginName, g); err != nil {
+	descriptor := &kubeauth.Descriptor{
+		CredentialType: api.CloudCredentialInfo_Google,
+		Matcher: kubeauth.Matcher{
+			AuthProviders: []string{pluginName},
+		},
+		Capabilities: kubeauth.Capabilities{
+			KubeconfigRefresh: true,
+			SingleLookup:      true,
+			Enumeration:       true,
+		},
+	}
+	if err := kubeauth.RegisterPlugin(pluginName, g, descriptor); err != nil {
 		logrus.Panicf("Error registering gcp auth plugin: %v", err)
 	}
 }
@@ -0,0 +1,107 @@
+package gcp_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+	"github.com/portworx/px-backup-api/pkg/kubeauth"
+	"github.com/portworx/px-backup-api/pkg/kubeauth/fake"
+	"github.com/portworx/px-backup-api/pkg/kubeauth/gcp"
+)
+
+const testProjectID = "px-backup-test"
+
+func newFake(t *testing.T, clusters ...*fake.Cluster) *api.CloudCredentialObject {
+	gke, err := fake.NewGCP(testProjectID, clusters...)
+	if err != nil {
+		t.Fatalf("failed to start the fake GCP: %v", err)
+	}
+	gcp.SetEndpoint(gke.URL())
+	t.Cleanup(func() {
+		gcp.SetEndpoint("")
+		gke.Close()
+	})
+	jsonKey, err := gke.ServiceAccountKey()
+	if err != nil {
+		t.Fatalf("failed to get the service account key: %v", err)
+	}
+	return fake.NewCloudCredential(&api.GoogleConfig{ProjectId: testProjectID, JsonKey: jsonKey})
+}
+
+func TestGetAllClients(t *testing.T) {
+	cloudCred := newFake(t,
+		&fake.Cluster{Name: "c1", ID: "id-1", Region: "us-central1", Version: "1.29.1-gke.1"},
+		&fake.Cluster{Name: "c1", ID: "id-2", Region: "europe-west1-b", Version: "1.28.5-gke.1"},
+		&fake.Cluster{Name: "c2", ID: "id-3", Region: "us-central1", Version: "1.29.1-gke.1"},
+	)
+	config := &api.ManagedClusterEnumerateRequest_GoogleConfig{}
+
+	pages := fake.AllClients(t, cloudCred, 2, config)
+	// The next tokens are the index of the location and the offset in
+	// its clusters. All the locations are listed at once by default
+	if len(pages) != 2 || pages[0].NextToken != "0:2" {
+		t.Errorf("expected 2 pages with a location token, got %v pages: %q", len(pages), pages[0].NextToken)
+	}
+	clients, _ := fake.Merge(pages)
+	expected := map[string]string{
+		"us-central1/c1":    "id-1",
+		"europe-west1-b/c1": "id-2",
+		"us-central1/c2":    "id-3",
+	}
+	if len(clients) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, clients)
+	}
+	for key, uid := range expected {
+		if clients[key] == nil || clients[key].Uid != uid {
+			t.Errorf("unexpected client for %v: %+v", key, clients[key])
+		}
+	}
+}
+
+func TestGetClient(t *testing.T) {
+	server := fake.NewAPIServer("v1.29.1-gke.1")
+	defer server.Close()
+	cloudCred := newFake(t,
+		&fake.Cluster{Name: "c1", Region: "us-central1", Version: "1.29.1-gke.1", Server: server},
+		&fake.Cluster{Name: "c1", Region: "europe-west1-b", Version: "1.28.5-gke.1"},
+	)
+
+	_, err := kubeauth.GetClientWithContext(context.Background(), cloudCred, "c1", "")
+	if err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("expected an ambiguity error, got %v", err)
+	}
+
+	client, err := kubeauth.GetClientWithContext(context.Background(), cloudCred, "us-central1/c1", "")
+	if err != nil {
+		t.Fatalf("GetClientWithContext failed: %v", err)
+	}
+	if client.Version != "1.29.1-gke.1" {
+		t.Errorf("unexpected client %+v", client)
+	}
+	fake.CheckClient(t, client.Rest, server)
+	if server.LastToken() == "" {
+		t.Errorf("expected the request to carry the service account token")
+	}
+}
+
+func TestGetClientInProject(t *testing.T) {
+	cloudCred := newFake(t, &fake.Cluster{Name: "c1", ID: "id-1", Region: "us-central1", Version: "1.29.1-gke.1"})
+
+	client, err := kubeauth.GetClientWithContext(context.Background(), cloudCred,
+		gcp.ClusterName(testProjectID, "us-central1", "c1"), "")
+	if err != nil {
+		t.Fatalf("GetClientWithContext failed: %v", err)
+	}
+	if client.Uid != "id-1" {
+		t.Errorf("unexpected client %+v", client)
+	}
+
+	// The project in the name is used instead of the one of the credential
+	_, err = kubeauth.GetClientWithContext(context.Background(), cloudCred,
+		gcp.ClusterName("other-project", "", "c1"), "")
+	if err == nil || !strings.Contains(err.Error(), "other-project") {
+		t.Errorf("expected the cluster to be looked up in other-project, got %v", err)
+	}
+}
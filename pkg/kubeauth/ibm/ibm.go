@@ -1,21 +1,24 @@
 package ibm
 
 import (
+	"archive/zip"
 	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
-	"os"
+	"path"
+	"sort"
+	"strconv"
 	"strings"
-	"sync"
 
-	"github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
 	"github.com/IBM-Cloud/bluemix-go/session"
 	api "github.com/portworx/px-backup-api/pkg/apis/v1"
 	"github.com/portworx/px-backup-api/pkg/kubeauth"
-	"github.com/portworx/sched-ops/k8s/core"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
@@ -31,10 +34,23 @@ const (
 	pluginName            = "ibm"
 	idpIssuerUrlKey       = "idp-issuer-url"
 	ibmIssuerUrlSubstring = "iam.cloud.ibm.com"
+	// maxArchiveFileSize is the size limit of the files
+	// in the cluster config archive
+	maxArchiveFileSize = 1 << 20
 )
 
 type ibm struct {
-	clusterMapLock map[string]*sync.Mutex
+	sessions *sessionCache
+}
+
+// clusterInfo holds the fields common to the classic and VPC clusters
+type clusterInfo struct {
+	ID                string
+	Name              string
+	Region            string
+	ResourceGroupID   string
+	MasterKubeVersion string
+	State             string
 }
 
 // Init initializes the gcp auth plugin
@@ -43,8 +59,8 @@ func (i *ibm) Init() error {
 }
 
 func (i *ibm) UpdateClient(
-	conn *grpc.ClientConn,
 	ctx context.Context,
+	conn *grpc.ClientConn,
 	cloudCredentialName string,
 	cloudCredentialUID string,
 	orgID string,
@@ -53,15 +69,11 @@ func (i *ibm) UpdateClient(
 ) (bool, string, error) {
 	if i.isIBMProvider(restConfig) {
 		// Check if the provided config has expired
-		coreInst, err := core.NewForConfig(restConfig)
-		if err == nil {
-			_, err = coreInst.GetVersion()
-			if err == nil {
-				// No need of updating the kubeconfig
-				// Return true since we found that this
-				// is a IBM config
-				return true, "", nil
-			}
+		if valid, _ := kubeauth.ValidateConfigWithContext(ctx, restConfig); valid {
+			// No need of updating the kubeconfig
+			// Return true since we found that this
+			// is a IBM config
+			return true, "", nil
 		}
 		// the config has expired
 		// update the tokens
@@ -80,7 +92,7 @@ func (i *ibm) UpdateClient(
 		}
 		cloudCredential := resp.GetCloudCredential()
 
-		kubeconfig, err := i.updateClient(restConfig, clientConfig, cloudCredential)
+		kubeconfig, err := i.updateClient(ctx, restConfig, clientConfig, cloudCredential)
 		if err != nil {
 			logrus.Errorf("Failed to update client: %v", err)
 			return false, "", err
@@ -91,23 +103,20 @@ func (i *ibm) UpdateClient(
 }
 
 func (i *ibm) UpdateClientByCredObject(
+	ctx context.Context,
 	cloudCredential *api.CloudCredentialObject,
 	restConfig *rest.Config,
 	clientConfig *clientcmdapi.Config,
 ) (bool, string, error) {
 	if i.isIBMProvider(restConfig) {
 		// Check if the provided config has expired
-		coreInst, err := core.NewForConfig(restConfig)
-		if err == nil {
-			_, err = coreInst.GetVersion()
-			if err == nil {
-				// No need of updating the kubeconfig
-				// Return true since we found that this
-				// is a IBM config
-				return true, "", nil
-			}
+		if valid, _ := kubeauth.ValidateConfigWithContext(ctx, restConfig); valid {
+			// No need of updating the kubeconfig
+			// Return true since we found that this
+			// is a IBM config
+			return true, "", nil
 		}
-		kubeconfig, err := i.updateClient(restConfig, clientConfig, cloudCredential)
+		kubeconfig, err := i.updateClient(ctx, restConfig, clientConfig, cloudCredential)
 		if err != nil {
 			logrus.Errorf("Failed to update client: %v", err)
 			return false, "", err
@@ -117,7 +126,7 @@ func (i *ibm) UpdateClientByCredObject(
 	return false, "", nil
 }
 
-func (i *ibm) updateClient(restConfig *rest.Config, clientConfig *clientcmdapi.Config, cloudCredential *api.CloudCredentialObject) (string, error) {
+func (i *ibm) updateClient(ctx context.Context, restConfig *rest.Config, clientConfig *clientcmdapi.Config, cloudCredential *api.CloudCredentialObject) (string, error) {
 	clusterName, region, err := parseConfig(clientConfig)
 	if err != nil {
 		return "", err
@@ -127,15 +136,7 @@ func (i *ibm) updateClient(restConfig *rest.Config, clientConfig *clientcmdapi.C
 		return "", fmt.Errorf("need IBM CloudCredential for IBM clusters. Provided %v", cloudCredential.GetCloudCredentialInfo().GetType())
 	}
 	apiKey := cloudCredential.GetCloudCredentialInfo().GetIbmConfig().GetApiKey()
-	sess, err := session.New()
-	if err != nil {
-		logrus.Errorf("InitSession: Failed to start a new session")
-		return "", err
-	}
-
-	sess.Config.BluemixAPIKey = apiKey
-
-	_, err = mccpv2.New(sess)
+	sess, err := i.sessions.getSession(ctx, apiKey)
 	if err != nil {
 		return "", err
 	}
@@ -143,133 +144,158 @@ func (i *ibm) updateClient(restConfig *rest.Config, clientConfig *clientcmdapi.C
 	if err != nil {
 		return "", fmt.Errorf("failed to create a cluster client: %v", err)
 	}
-	clusterAPI := clusterClient.Clusters()
 
-	// IBM client library is not thread safe for the same cluster
-	// It will error out if it finds that another kubeconfig
-	// being generated under that directory. We don't want to keep
-	// populating the /tmp directory with new kubeconfigs so after the
-	// rest.Config is populated we will cleanup the directory
-	lock, exists := i.clusterMapLock[clusterName]
-	if !exists {
-		lock = &sync.Mutex{}
-		i.clusterMapLock[clusterName] = lock
-	}
-	lock.Lock()
-	defer lock.Unlock()
-
-	configPath := "/tmp/kube/" + clusterName
-	i.cleanupKubeconfigPath(configPath)
-	// Create a path to save the new kubeconfig
-	if err := os.MkdirAll(configPath, 0777); err != nil {
-		logrus.Debugf("failed to create config path: %v", err)
-	}
-	defer func() {
-		i.cleanupKubeconfigPath(configPath)
-	}()
+	logrus.Debugf("Updating rest config for cluster (%v): %v", clusterName, restConfig.String())
 
 	target := v1.ClusterTargetHeader{
 		Region: region,
 	}
-	clusterConfig, err := clusterAPI.GetClusterConfigDetail(clusterName, configPath, true, target)
+	kubeconfigBytes, restConfigCopy, err := i.getClusterConfig(clusterClient, clusterName, target)
 	if err != nil {
-		return "", fmt.Errorf("failed to get cluster config: %v", err)
+		return "", err
 	}
 
-	logrus.Debugf("Updating rest config for cluster (%v): %v", clusterName, restConfig.String())
+	_, err = kubeauth.ValidateConfigWithContext(ctx, restConfigCopy)
+	if err == nil {
+		*restConfig = *restConfigCopy
+	}
+	return kubeconfigBytes, err
+}
 
-	kubeConfigPath := clusterConfig.FilePath
-	kubeconfigBytes := i.getKubeconfig(kubeConfigPath)
+// configDownloader is implemented by the container service client
+// through the embedded bluemix REST client
+type configDownloader interface {
+	Get(path string, respV interface{}, extraHeader ...interface{}) (*http.Response, error)
+}
 
-	// First parse the config
-	defClient, err := clientcmd.NewClientConfigFromBytes([]byte(kubeconfigBytes))
+// getClusterConfig fetches the kubeconfig of the cluster from IBM and
+// returns it along with the rest config built from it. The config archive
+// is extracted in memory so that concurrent calls don't share any state
+func (i *ibm) getClusterConfig(
+	clusterClient v1.ContainerServiceAPI,
+	clusterName string,
+	target v1.ClusterTargetHeader,
+) (string, *rest.Config, error) {
+	downloader, ok := clusterClient.(configDownloader)
+	if !ok {
+		return "", nil, fmt.Errorf("cluster client does not support downloading the cluster config")
+	}
+	var archive bytes.Buffer
+	configPath := fmt.Sprintf("/v1/clusters/%s/config/admin", url.PathEscape(clusterName))
+	if _, err := downloader.Get(configPath, &archive, target.ToMap()); err != nil {
+		return "", nil, fmt.Errorf("failed to get cluster config: %v", err)
+	}
+	rawConfig, err := parseConfigArchive(archive.Bytes())
 	if err != nil {
-		return "", err
+		return "", nil, fmt.Errorf("failed to parse cluster config: %v", err)
 	}
-	rawConfig, err := defClient.RawConfig()
+	kubeconfig, err := getKubeconfig(rawConfig)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
-	// Then create a default client config with the default loading rules
-	defClient = clientcmd.NewDefaultClientConfig(rawConfig, &clientcmd.ConfigOverrides{})
-	if err != nil {
-		return "", err
-	}
-	restConfigCopy, err := defClient.ClientConfig()
+	// Create a default client config with the default loading rules
+	defClient := clientcmd.NewDefaultClientConfig(*rawConfig, &clientcmd.ConfigOverrides{})
+	restConfig, err := defClient.ClientConfig()
 	if err != nil {
-		return "", err
-	}
-
-	_, err = i.validateConfig(restConfigCopy)
-	if err == nil {
-		*restConfig = *restConfigCopy
+		return "", nil, err
 	}
-	return kubeconfigBytes, err
+	return kubeconfig, restConfig, nil
 }
 
-// getKubeconfig will read the kubeconfig from the provided path
-// and return the flattened and minifed kubeconfig in string representation
-// This function will return an empty string if it fails to read the file or parse it
-// This function is inspired from "kubectl config view --minify --flatten" implementation
-func (i *ibm) getKubeconfig(kubeConfigPath string) string {
-	content, err := os.ReadFile(kubeConfigPath)
+// parseConfigArchive parses the kubeconfig from the zip archive returned by
+// IBM and inlines the certificates it refers to from the same archive
+func parseConfigArchive(archive []byte) (*clientcmdapi.Config, error) {
+	zipReader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
 	if err != nil {
-		return ""
+		return nil, err
 	}
-	client, err := clientcmd.NewClientConfigFromBytes(content)
-	if err != nil {
-		return ""
+	var kubeconfig []byte
+	files := make(map[string][]byte)
+	for _, f := range zipReader.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		content, err := readArchiveFile(f)
+		if err != nil {
+			return nil, err
+		}
+		name := path.Base(f.Name)
+		if strings.HasSuffix(name, ".yml") || strings.HasSuffix(name, ".yaml") {
+			kubeconfig = content
+			continue
+		}
+		files[name] = content
 	}
-	rawConfig, err := client.RawConfig()
+	if kubeconfig == nil {
+		return nil, fmt.Errorf("kubeconfig not found in the config archive")
+	}
+	rawConfig, err := clientcmd.Load(kubeconfig)
 	if err != nil {
-		return ""
+		return nil, err
 	}
-	// Set the location of origin to the kubeconfig path
-	// This allows the minify and flatten functions to find
-	// the certs
-	for _, authInfos := range rawConfig.AuthInfos {
-		authInfos.LocationOfOrigin = kubeConfigPath
+	inline := func(file *string, data *[]byte) error {
+		if *file == "" {
+			return nil
+		}
+		content, exists := files[path.Base(*file)]
+		if !exists {
+			return fmt.Errorf("%v not found in the config archive", *file)
+		}
+		*data = content
+		*file = ""
+		return nil
 	}
 	for _, cluster := range rawConfig.Clusters {
-		cluster.LocationOfOrigin = kubeConfigPath
+		if err := inline(&cluster.CertificateAuthority, &cluster.CertificateAuthorityData); err != nil {
+			return nil, err
+		}
 	}
-	if err := clientcmdapi.MinifyConfig(&rawConfig); err != nil {
-		return ""
+	for _, authInfo := range rawConfig.AuthInfos {
+		if err := inline(&authInfo.ClientCertificate, &authInfo.ClientCertificateData); err != nil {
+			return nil, err
+		}
+		if err := inline(&authInfo.ClientKey, &authInfo.ClientKeyData); err != nil {
+			return nil, err
+		}
 	}
-	if err := clientcmdapi.FlattenConfig(&rawConfig); err != nil {
-		return ""
+	return rawConfig, nil
+}
+
+func readArchiveFile(f *zip.File) ([]byte, error) {
+	r, err := f.Open()
+	if err != nil {
+		return nil, err
 	}
-	convertedObj, err := latest.Scheme.ConvertToVersion(&rawConfig, latest.ExternalVersion)
+	defer r.Close()
+	content, err := io.ReadAll(io.LimitReader(r, maxArchiveFileSize+1))
 	if err != nil {
-		return ""
+		return nil, err
 	}
-	yamlPrinter := &printers.YAMLPrinter{}
-	var b bytes.Buffer
-	if err := yamlPrinter.PrintObj(runtime.Object(convertedObj), &b); err != nil {
-		return ""
+	if len(content) > maxArchiveFileSize {
+		return nil, fmt.Errorf("%v in the config archive is too large", f.Name)
 	}
-	return b.String()
+	return content, nil
 }
 
-func (i *ibm) cleanupKubeconfigPath(configPath string) {
-	// Cleanup old path
-	if err := os.RemoveAll(configPath); err != nil {
-		logrus.Debugf("failed to cleanup config path: %v", err)
+// getKubeconfig returns the minifed kubeconfig in string representation.
+// The certificates should already be inlined in the provided config
+// This function is inspired from "kubectl config view --minify --flatten" implementation
+func getKubeconfig(rawConfig *clientcmdapi.Config) (string, error) {
+	minConfig := rawConfig.DeepCopy()
+	if err := clientcmdapi.MinifyConfig(minConfig); err != nil {
+		return "", err
 	}
-}
-
-func (i *ibm) validateConfig(restConfig *rest.Config) (bool, error) {
-	// Check if the provided config has expired
-	coreInst, err := core.NewForConfig(restConfig)
+	convertedObj, err := latest.Scheme.ConvertToVersion(minConfig, latest.ExternalVersion)
 	if err != nil {
-		return false, err
+		return "", err
 	}
-	_, err = coreInst.GetVersion()
-	if err == nil {
-		return true, nil
+	yamlPrinter := &printers.YAMLPrinter{}
+	var b bytes.Buffer
+	if err := yamlPrinter.PrintObj(runtime.Object(convertedObj), &b); err != nil {
+		return "", err
 	}
-	return false, err
+	return b.String(), nil
 }
 
 func (i *ibm) isIBMProvider(client *rest.Config) bool {
@@ -326,26 +352,227 @@ func parseConfig(clientConfig *clientcmdapi.Config) (string, string, error) {
 
 }
 
+// ClusterName returns the cluster name to be passed to GetClient for the
+// cluster with the given name or ID in the resource group. The resource
+// groups the api key has access to are searched if resourceGroup is empty
+func ClusterName(resourceGroup string, name string) string {
+	if resourceGroup != "" {
+		return resourceGroup + "/" + name
+	}
+	return name
+}
+
+// GetClient returns the client for the cluster with the given name or ID.
+// The cluster name can be prefixed with the ID of its resource group,
+// "<resource-group>/<cluster-name>", as built by ClusterName
 func (i *ibm) GetClient(
+	ctx context.Context,
 	cloudCredential *api.CloudCredentialObject,
 	clusterName string,
 	region string,
 ) (*kubeauth.PluginClient, error) {
-	return nil, fmt.Errorf("not supported")
+	if cloudCredential.GetCloudCredentialInfo().GetType() != api.CloudCredentialInfo_IBM {
+		return nil, fmt.Errorf("cloud credentials are not for ibm")
+	}
+	sess, err := i.sessions.getSession(ctx, cloudCredential.GetCloudCredentialInfo().GetIbmConfig().GetApiKey())
+	if err != nil {
+		return nil, err
+	}
+	clusterClient, err := v1.New(sess)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a cluster client: %v", err)
+	}
+	target := v1.ClusterTargetHeader{
+		Region: region,
+	}
+	if tokens := strings.SplitN(clusterName, "/", 2); len(tokens) == 2 {
+		target.ResourceGroup, clusterName = tokens[0], tokens[1]
+	}
+	cluster, err := clusterClient.Clusters().FindWithOutShowResourcesCompatible(clusterName, target)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find cluster %v: %v", clusterName, err)
+	}
+	return i.getPluginClient(clusterClient, &clusterInfo{
+		ID:                cluster.ID,
+		Name:              cluster.Name,
+		Region:            cluster.Region,
+		ResourceGroupID:   cluster.ResourceGroupID,
+		MasterKubeVersion: cluster.MasterKubeVersion,
+		State:             cluster.State,
+	})
 }
 
+// GetAllClients returns the clients for all the IKS and Red Hat OpenShift on
+// IBM Cloud clusters, both classic and VPC, which the api key has access to.
+// IBM returns all the clusters at once, hence the results are paginated using
+// an opaque token which is the offset of the next cluster
 func (i *ibm) GetAllClients(
+	ctx context.Context,
 	cloudCredential *api.CloudCredentialObject,
 	maxResult int64,
 	config interface{},
-) (map[string]*kubeauth.PluginClient,  *string, error) {
-	return nil, nil, fmt.Errorf("not supported")
+) (map[string]*kubeauth.PluginClient, *string, error) {
+	funct := "GetAllClients"
+	if cloudCredential.GetCloudCredentialInfo().GetType() != api.CloudCredentialInfo_IBM {
+		return nil, nil, fmt.Errorf("cloud credentials are not for ibm")
+	}
+	ibmCfg, ok := config.(*api.ManagedClusterEnumerateRequest_IBMConfig)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid config %T provided for IBM cluster scan", config)
+	}
+	offset := 0
+	if ibmCfg.GetNextToken() != "" {
+		var err error
+		if offset, err = strconv.Atoi(ibmCfg.GetNextToken()); err != nil || offset < 0 {
+			return nil, nil, fmt.Errorf("invalid next token %v provided for IBM cluster scan", ibmCfg.GetNextToken())
+		}
+	}
+	sess, err := i.sessions.getSession(ctx, cloudCredential.GetCloudCredentialInfo().GetIbmConfig().GetApiKey())
+	if err != nil {
+		return nil, nil, err
+	}
+	clusters, err := listClusters(sess, ibmCfg.GetRegions(), ibmCfg.GetResourceGroups())
+	if err != nil {
+		return nil, nil, err
+	}
+	logrus.Tracef("%s: listed %v clusters", funct, len(clusters))
+	clusterClient, err := v1.New(sess)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create a cluster client: %v", err)
+	}
+
+	restConfigs := make(map[string]*kubeauth.PluginClient)
+	var nextToken *string
+	for ; offset < len(clusters); offset++ {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		if maxResult != 0 && int64(len(restConfigs)) >= maxResult {
+			token := strconv.Itoa(offset)
+			nextToken = &token
+			break
+		}
+		cluster := clusters[offset]
+		pluginClient, err := i.getPluginClient(clusterClient, cluster)
+		// On error continue to next cluster as we don't want to stop the
+		// scan for one cluster error.
+		if err != nil {
+			kubeauth.ReportSkippedCluster(ctx, cluster.Name, &kubeauth.SkippedCluster{
+				Uid:     cluster.ID,
+				Version: parseVersion(cluster.MasterKubeVersion),
+				Region:  cluster.Region,
+				Reason:  err.Error(),
+			})
+			continue
+		}
+		restConfigs[cluster.Name] = pluginClient
+	}
+	return restConfigs, nextToken, nil
+}
+
+func (i *ibm) getPluginClient(clusterClient v1.ContainerServiceAPI, cluster *clusterInfo) (*kubeauth.PluginClient, error) {
+	target := v1.ClusterTargetHeader{
+		Region:        cluster.Region,
+		ResourceGroup: cluster.ResourceGroupID,
+	}
+	kubeconfig, restConfig, err := i.getClusterConfig(clusterClient, cluster.Name, target)
+	if err != nil {
+		return nil, err
+	}
+	return &kubeauth.PluginClient{
+		Kubeconfig: kubeconfig,
+		Rest:       restConfig,
+		Uid:        cluster.ID,
+		Version:    parseVersion(cluster.MasterKubeVersion),
+	}, nil
+}
+
+// listClusters returns the classic and VPC clusters in the given regions and
+// resource groups sorted by their ID. Empty regions or resource groups
+// select all of them
+func listClusters(sess *session.Session, regions []string, resourceGroups []string) ([]*clusterInfo, error) {
+	clusterClient, err := v1.New(sess)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a cluster client: %v", err)
+	}
+	vpcClusterClient, err := v2.New(sess)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a vpc cluster client: %v", err)
+	}
+	if len(resourceGroups) == 0 {
+		resourceGroups = []string{""}
+	}
+	regionSet := make(map[string]bool)
+	for _, region := range regions {
+		regionSet[region] = true
+	}
+	clusterMap := make(map[string]*clusterInfo)
+	addCluster := func(cluster *clusterInfo) {
+		if len(regionSet) > 0 && !regionSet[cluster.Region] {
+			return
+		}
+		clusterMap[cluster.ID] = cluster
+	}
+	for _, resourceGroup := range resourceGroups {
+		classicClusters, err := clusterClient.Clusters().List(v1.ClusterTargetHeader{ResourceGroup: resourceGroup})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list classic clusters: %v", err)
+		}
+		for _, cluster := range classicClusters {
+			addCluster(&clusterInfo{
+				ID:                cluster.ID,
+				Name:              cluster.Name,
+				Region:            cluster.Region,
+				ResourceGroupID:   cluster.ResourceGroupID,
+				MasterKubeVersion: cluster.MasterKubeVersion,
+				State:             cluster.State,
+			})
+		}
+		vpcClusters, err := vpcClusterClient.Clusters().List(v2.ClusterTargetHeader{ResourceGroup: resourceGroup})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list vpc clusters: %v", err)
+		}
+		for _, cluster := range vpcClusters {
+			addCluster(&clusterInfo{
+				ID:                cluster.ID,
+				Name:              cluster.Name,
+				Region:            cluster.Region,
+				ResourceGroupID:   cluster.ResourceGroupID,
+				MasterKubeVersion: cluster.MasterKubeVersion,
+				State:             cluster.State,
+			})
+		}
+	}
+	clusters := make([]*clusterInfo, 0, len(clusterMap))
+	for _, cluster := range clusterMap {
+		clusters = append(clusters, cluster)
+	}
+	// Keep the order stable across pages
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].ID < clusters[j].ID
+	})
+	return clusters, nil
+}
 
+// parseVersion returns the version without the IBM build suffix
+// Sample IBM versions: "1.27.4_1540", "4.13.9_1536_openshift"
+func parseVersion(version string) string {
+	return strings.Split(version, "_")[0]
 }
 
 func init() {
-	cMap := make(map[string]*sync.Mutex)
-	if err := kubeauth.Register(pluginName, &ibm{cMap}); err != nil {
+	descriptor := &kubeauth.Descriptor{
+		CredentialType: api.CloudCredentialInfo_IBM,
+		Matcher: kubeauth.Matcher{
+			IssuerURLs: []string{ibmIssuerUrlSubstring},
+		},
+		Capabilities: kubeauth.Capabilities{
+			KubeconfigRefresh: true,
+			SingleLookup:      true,
+			Enumeration:       true,
+		},
+	}
+	if err := kubeauth.RegisterPlugin(pluginName, &ibm{sessions: newSessionCache()}, descriptor); err != nil {
 		logrus.Panicf("Error registering ibm auth plugin: %v", err)
 	}
 }
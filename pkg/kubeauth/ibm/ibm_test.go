@@ -0,0 +1,96 @@
+package ibm_test
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+	"github.com/portworx/px-backup-api/pkg/kubeauth"
+	"github.com/portworx/px-backup-api/pkg/kubeauth/fake"
+	"github.com/portworx/px-backup-api/pkg/kubeauth/ibm"
+)
+
+func newFake(t *testing.T, apiKey string, clusters ...*fake.Cluster) *fake.IBM {
+	cloud := fake.NewIBM(apiKey, clusters...)
+	ibm.SetEndpoints(cloud.URL(), cloud.URL())
+	t.Cleanup(func() {
+		ibm.SetEndpoints("", "")
+		cloud.Close()
+	})
+	return cloud
+}
+
+func newCloudCredential(apiKey string) *api.CloudCredentialObject {
+	return fake.NewCloudCredential(&api.IBMConfig{ApiKey: apiKey})
+}
+
+func TestGetAllClients(t *testing.T) {
+	const apiKey = "ibm-get-all-clients"
+	cloud := newFake(t, apiKey,
+		&fake.Cluster{Name: "classic", ID: "id-classic", Region: "us-south", ResourceGroup: "rg1", Version: "1.29.1_1530"},
+		&fake.Cluster{Name: "vpc", ID: "id-vpc", Region: "us-east", ResourceGroup: "rg2", Version: "1.28.5_1540", VPC: true},
+	)
+	cloudCred := newCloudCredential(apiKey)
+
+	// The classic and the VPC clusters are paged through together
+	pages := fake.AllClients(t, cloudCred, 1, &api.ManagedClusterEnumerateRequest_IBMConfig{})
+	if len(pages) != 2 {
+		t.Errorf("expected 2 pages, got %v", len(pages))
+	}
+	clients, _ := fake.Merge(pages)
+	if clients["classic"] == nil || clients["classic"].Uid != "id-classic" || clients["classic"].Version != "1.29.1" {
+		t.Errorf("unexpected classic cluster client %+v", clients["classic"])
+	}
+	if clients["vpc"] == nil || clients["vpc"].Uid != "id-vpc" || clients["vpc"].Version != "1.28.5" {
+		t.Errorf("unexpected VPC cluster client %+v", clients["vpc"])
+	}
+
+	clients, _, _, err := kubeauth.GetAllClientsWithSkipped(
+		context.Background(), cloudCred, 0, &api.ManagedClusterEnumerateRequest_IBMConfig{ResourceGroups: []string{"rg2"}})
+	if err != nil {
+		t.Fatalf("GetAllClientsWithSkipped failed: %v", err)
+	}
+	if len(clients) != 1 || clients["vpc"] == nil {
+		t.Errorf("expected only the cluster in rg2, got %v", clients)
+	}
+	if logins := cloud.Logins(); logins != 1 {
+		t.Errorf("expected the session to be reused, got %v logins", logins)
+	}
+}
+
+func TestGetClient(t *testing.T) {
+	const apiKey = "ibm-get-client"
+	server := fake.NewAPIServer("v1.29.1+IKS")
+	defer server.Close()
+	newFake(t, apiKey, &fake.Cluster{Name: "c1", Region: "us-south", Version: "1.29.1_1530", Server: server})
+
+	client, err := kubeauth.GetClientWithContext(context.Background(), newCloudCredential(apiKey), "c1", "us-south")
+	if err != nil {
+		t.Fatalf("GetClientWithContext failed: %v", err)
+	}
+	fake.CheckClient(t, client.Rest, server)
+
+	if _, err := kubeauth.GetClientWithContext(context.Background(), newCloudCredential("wrong"), "c1", "us-south"); err == nil {
+		t.Errorf("expected an invalid API key to fail")
+	}
+}
+
+func TestGetClientInResourceGroup(t *testing.T) {
+	const apiKey = "ibm-get-client-in-resource-group"
+	newFake(t, apiKey,
+		&fake.Cluster{Name: "c1", ID: "id-rg1", Region: "us-south", ResourceGroup: "rg1", Version: "1.29.1_1530"},
+		&fake.Cluster{Name: "c1", ID: "id-rg2", Region: "us-south", ResourceGroup: "rg2", Version: "1.28.5_1540"},
+	)
+	cloudCred := newCloudCredential(apiKey)
+
+	client, err := kubeauth.GetClientWithContext(context.Background(), cloudCred, ibm.ClusterName("rg2", "c1"), "us-south")
+	if err != nil {
+		t.Fatalf("GetClientWithContext failed: %v", err)
+	}
+	if client.Uid != "id-rg2" || client.Version != "1.28.5" {
+		t.Errorf("expected the cluster in rg2, got %+v", client)
+	}
+	if _, err := kubeauth.GetClientWithContext(context.Background(), cloudCred, ibm.ClusterName("rg3", "c1"), "us-south"); err == nil {
+		t.Errorf("expected the cluster not to be found in rg3")
+	}
+}
@@ -0,0 +1,239 @@
+package ibm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/authentication"
+	"github.com/IBM-Cloud/bluemix-go/helpers"
+	bmxhttp "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/rest"
+	"github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/portworx/px-backup-api/pkg/kubeauth"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// iamTokenRefreshWindow is how long before its expiry a cached
+	// IAM token is regenerated
+	iamTokenRefreshWindow = 5 * time.Minute
+	// defaultIAMTokenLifetime is used if the expiry of an IAM
+	// token cannot be parsed
+	defaultIAMTokenLifetime = 20 * time.Minute
+	// defaultSessionIdleTTL is how long a session is kept in the
+	// cache after it was last used
+	defaultSessionIdleTTL = kubeauth.DefaultTokenSourceIdleTTL
+	// defaultMaxSessions is the maximum number of sessions kept in
+	// the cache. The least recently used ones are evicted beyond it
+	defaultMaxSessions = kubeauth.DefaultMaxTokenSources
+)
+
+var (
+	endpointLock sync.RWMutex
+	// containerEndpoint overrides the IBM Cloud Kubernetes Service
+	// endpoint when set
+	containerEndpoint string
+	// iamEndpoint overrides the IBM Cloud IAM endpoint when set
+	iamEndpoint string
+
+	transportLock sync.Mutex
+	// transports are the transports shared by the IBM Cloud clients,
+	// keyed by whether they skip the TLS verification
+	transports = make(map[bool]http.RoundTripper)
+)
+
+// SetEndpoints overrides the IBM Cloud Kubernetes Service and IAM endpoints.
+// It is meant for running the plugin against a fake IBM Cloud. Empty
+// endpoints restore the default endpoints
+func SetEndpoints(container string, iam string) {
+	endpointLock.Lock()
+	defer endpointLock.Unlock()
+	containerEndpoint = container
+	iamEndpoint = iam
+}
+
+func getEndpoints() (string, string) {
+	endpointLock.RLock()
+	defer endpointLock.RUnlock()
+	return containerEndpoint, iamEndpoint
+}
+
+// sessionCache caches the IAM tokens per API key so that they are
+// reused across the calls instead of logging in on every call. The
+// sessions not used for defaultSessionIdleTTL are evicted, as are the
+// least recently used ones beyond defaultMaxSessions
+type sessionCache struct {
+	idleTTL     time.Duration
+	maxSessions int
+
+	lock     sync.Mutex
+	sessions map[string]*cachedSession
+}
+
+type cachedSession struct {
+	// lastUsed is the time the session was last used at. It is
+	// guarded by the lock of the cache
+	lastUsed time.Time
+
+	// lock serializes the logins for the same API key
+	lock   sync.Mutex
+	config *bluemix.Config
+	expiry time.Time
+}
+
+func newSessionCache() *sessionCache {
+	return &sessionCache{
+		idleTTL:     defaultSessionIdleTTL,
+		maxSessions: defaultMaxSessions,
+		sessions:    make(map[string]*cachedSession),
+	}
+}
+
+// getSession returns a session for the API key whose requests are bound
+// to the provided context. The session should not be used once the
+// context is done
+func (s *sessionCache) getSession(ctx context.Context, apiKey string) (*session.Session, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("api key not provided in the IBM CloudCredential")
+	}
+	cached := s.get(apiKey)
+	cached.lock.Lock()
+	defer cached.lock.Unlock()
+	if cached.config == nil || time.Now().Add(iamTokenRefreshWindow).After(cached.expiry) {
+		config, err := login(ctx, apiKey)
+		if err != nil {
+			cached.config = nil
+			return nil, err
+		}
+		cached.config = config
+		cached.expiry = tokenExpiry(config.IAMAccessToken)
+	}
+	return &session.Session{Config: configWithContext(ctx, cached.config)}, nil
+}
+
+func (s *sessionCache) get(apiKey string) *cachedSession {
+	// Don't keep the API keys around as map keys. The endpoints are part
+	// of the key as the tokens are only valid for the IAM they came from
+	container, iam := getEndpoints()
+	sum := sha256.Sum256([]byte(container + "\n" + iam + "\n" + apiKey))
+	key := hex.EncodeToString(sum[:])
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	now := time.Now()
+	cached, exists := s.sessions[key]
+	if !exists {
+		s.evict(now)
+		cached = &cachedSession{}
+		s.sessions[key] = cached
+	}
+	cached.lastUsed = now
+	return cached
+}
+
+// evict removes the idle sessions and, if the cache is still full, the
+// least recently used one. An evicted session keeps working for the
+// calls using it. It expects the lock of the cache to be held
+func (s *sessionCache) evict(now time.Time) {
+	var lruKey string
+	var lruTime time.Time
+	for key, cached := range s.sessions {
+		if now.Sub(cached.lastUsed) > s.idleTTL {
+			delete(s.sessions, key)
+			continue
+		}
+		if lruKey == "" || cached.lastUsed.Before(lruTime) {
+			lruKey, lruTime = key, cached.lastUsed
+		}
+	}
+	if len(s.sessions) >= s.maxSessions && lruKey != "" {
+		delete(s.sessions, lruKey)
+	}
+}
+
+// login returns a config with the IAM tokens for the API key
+func login(ctx context.Context, apiKey string) (*bluemix.Config, error) {
+	sess, err := session.New()
+	if err != nil {
+		logrus.Errorf("InitSession: Failed to start a new session")
+		return nil, err
+	}
+	config := sess.Config
+	config.BluemixAPIKey = apiKey
+	container, iam := getEndpoints()
+	if container != "" {
+		config.Endpoint = helpers.String(container)
+	}
+	if iam != "" {
+		config.TokenProviderEndpoint = helpers.String(iam)
+	}
+
+	loginConfig := configWithContext(ctx, config)
+	iamRepo, err := authentication.NewIAMAuthRepository(loginConfig, &rest.Client{
+		DefaultHeader: http.Header{
+			"User-Agent": []string{bmxhttp.UserAgent()},
+		},
+		HTTPClient: loginConfig.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := iamRepo.AuthenticateAPIKey(apiKey); err != nil {
+		return nil, fmt.Errorf("failed to login to IBM Cloud IAM: %v", err)
+	}
+	config.IAMAccessToken = loginConfig.IAMAccessToken
+	config.IAMRefreshToken = loginConfig.IAMRefreshToken
+	return config, nil
+}
+
+// configWithContext returns a copy of the config whose requests are
+// bound to the provided context. The requests share the transport of
+// the other configs so that the connections are reused across calls
+func configWithContext(ctx context.Context, config *bluemix.Config) *bluemix.Config {
+	config = config.Copy()
+	if deadline, ok := ctx.Deadline(); ok {
+		if timeout := time.Until(deadline); timeout < config.HTTPTimeout {
+			config.HTTPTimeout = timeout
+		}
+		// The SDK sleeps between the retries without honoring the
+		// deadline, so fail fast instead
+		config.MaxRetries = helpers.Int(0)
+	}
+	config.HTTPClient = &http.Client{
+		Transport: kubeauth.NewContextRoundTripper(ctx, getTransport(config)),
+		Timeout:   config.HTTPTimeout,
+	}
+	return config
+}
+
+// getTransport returns the transport shared by the configs with the same
+// TLS verification
+func getTransport(config *bluemix.Config) http.RoundTripper {
+	transportLock.Lock()
+	defer transportLock.Unlock()
+	transport, exists := transports[config.SSLDisable]
+	if !exists {
+		transport = bmxhttp.NewHTTPClient(config).Transport
+		transports[config.SSLDisable] = transport
+	}
+	return transport
+}
+
+// tokenExpiry returns the expiry from the claims of the IAM access token,
+// which is of the form "Bearer <jwt>"
+func tokenExpiry(accessToken string) time.Time {
+	fields := strings.Fields(accessToken)
+	if len(fields) > 0 {
+		if expiry := kubeauth.TokenExpiry(fields[len(fields)-1]); expiry != nil {
+			return *expiry
+		}
+	}
+	return time.Now().Add(defaultIAMTokenLifetime)
+}
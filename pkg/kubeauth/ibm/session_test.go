@@ -0,0 +1,77 @@
+package ibm
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/portworx/px-backup-api/pkg/kubeauth/fake"
+)
+
+func isCached(s *sessionCache, cached *cachedSession) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, c := range s.sessions {
+		if c == cached {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSessionCacheEviction(t *testing.T) {
+	s := newSessionCache()
+	s.idleTTL = time.Hour
+	s.maxSessions = 2
+
+	idle := s.get("idle")
+	idle.lastUsed = time.Now().Add(-2 * time.Hour)
+	a := s.get("a")
+	if isCached(s, idle) || !isCached(s, a) {
+		t.Fatalf("expected the idle session to be evicted")
+	}
+	if s.get("a") != a {
+		t.Errorf("expected the cached session to be returned")
+	}
+
+	b := s.get("b")
+	b.lastUsed = time.Now().Add(-time.Minute)
+	c := s.get("c")
+	if !isCached(s, a) || isCached(s, b) || !isCached(s, c) {
+		t.Errorf("expected the least recently used session to be evicted")
+	}
+}
+
+func TestSessionCacheReusesLogins(t *testing.T) {
+	cloud := fake.NewIBM("api-key")
+	SetEndpoints(cloud.URL(), cloud.URL())
+	defer func() {
+		SetEndpoints("", "")
+		cloud.Close()
+	}()
+
+	s := newSessionCache()
+	transports := make([]http.RoundTripper, 0)
+	for i := 0; i < 2; i++ {
+		sess, err := s.getSession(context.Background(), "api-key")
+		if err != nil {
+			t.Fatalf("getSession failed: %v", err)
+		}
+		wrapped, ok := sess.Config.HTTPClient.Transport.(interface{ WrappedRoundTripper() http.RoundTripper })
+		if !ok {
+			t.Fatalf("expected the requests to be bound to the context")
+		}
+		transports = append(transports, wrapped.WrappedRoundTripper())
+	}
+	if cloud.Logins() != 1 {
+		t.Errorf("expected a single login, got %v", cloud.Logins())
+	}
+	if transports[0] != transports[1] {
+		t.Errorf("expected the sessions to share the transport")
+	}
+
+	if _, err := s.getSession(context.Background(), "wrong"); err == nil {
+		t.Errorf("expected the login with a wrong API key to fail")
+	}
+}
@@ -2,6 +2,14 @@ package kubeauth
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	api "github.com/portworx/px-backup-api/pkg/apis/v1"
 	"github.com/portworx/sched-ops/k8s/core"
@@ -10,6 +18,7 @@ import (
 	"google.golang.org/grpc"
 	"k8s.io/client-go/rest"
 	clientcmd "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/transport"
 )
 
 // PluginClient returns a k8s client returned by one of the
@@ -23,9 +32,12 @@ type PluginClient struct {
 	Uid string
 	// k8s version
 	Version string
+	// Region of the managed cluster
+	Region string
 }
 
-// Plugin is the interface the plugins need to implement
+// Plugin is the interface the plugins need to implement.
+// Deprecated: Implement PluginV2 instead
 type Plugin interface {
 	UpdateClient(
 		conn *grpc.ClientConn,
@@ -56,20 +68,143 @@ type Plugin interface {
 	) (map[string]*PluginClient, *string, error)
 }
 
+// PluginV2 is the interface the plugins need to implement. The context
+// provided to every method bounds the calls made to the cloud provider
+// and the cluster, and should be honored for deadlines and cancellation
+type PluginV2 interface {
+	UpdateClient(
+		ctx context.Context,
+		conn *grpc.ClientConn,
+		cloudCredentialName string,
+		cloudCredentialUID string,
+		orgID string,
+		restConfig *rest.Config,
+		clientConfig *clientcmd.Config,
+	) (bool, string, error)
+
+	UpdateClientByCredObject(
+		ctx context.Context,
+		cloudCred *api.CloudCredentialObject,
+		restConfig *rest.Config,
+		clientConfig *clientcmd.Config,
+	) (bool, string, error)
+
+	GetClient(
+		ctx context.Context,
+		cloudCred *api.CloudCredentialObject,
+		clusterName string,
+		region string,
+	) (*PluginClient, error)
+
+	GetAllClients(
+		ctx context.Context,
+		cloudCred *api.CloudCredentialObject,
+		maxResults int64,
+		config interface{},
+	) (map[string]*PluginClient, *string, error)
+}
+
+// Capabilities declares the operations supported by a plugin
+type Capabilities struct {
+	// KubeconfigRefresh is set if the plugin can refresh the credentials
+	// of a kubeconfig through UpdateClient and UpdateClientByCredObject
+	KubeconfigRefresh bool
+	// SingleLookup is set if the plugin can get a single cluster
+	// through GetClient
+	SingleLookup bool
+	// Enumeration is set if the plugin can list clusters
+	// through GetAllClients
+	Enumeration bool
+}
+
+// Matcher declares the kubeconfig auth methods handled by a plugin.
+// A kubeconfig matches if any one of the conditions is met
+type Matcher struct {
+	// ExecCommands are the exec credential plugin commands.
+	// Only the base name of the kubeconfig command is compared.
+	// An entry of the form "<command> <first-arg>", like
+	// "kubectl oidc-login", also requires the first exec arg so
+	// that generic launchers are not claimed as a whole
+	ExecCommands []string
+	// AuthProviders are the auth provider names
+	AuthProviders []string
+	// IssuerURLs are matched as substrings of the idp-issuer-url
+	// of the auth provider config
+	IssuerURLs []string
+	// ServerPathPrefixes are matched as prefixes of the path
+	// of the kubeconfig server url
+	ServerPathPrefixes []string
+}
+
+// Descriptor describes how requests are routed to a plugin
+type Descriptor struct {
+	// CredentialType is the type of the cloud credentials
+	// handled by the plugin
+	CredentialType api.CloudCredentialInfo_Type
+	// Matcher selects the kubeconfigs handled by the plugin
+	Matcher Matcher
+	// Capabilities are the operations supported by the plugin
+	Capabilities Capabilities
+	// AllowedExecCommands are the exec credential plugin commands
+	// allowed by the default kubeconfig policy, along with their
+	// required leading args, like "aws eks get-token"
+	AllowedExecCommands []string
+}
+
+// NoPluginMatchedError is returned when none of the registered
+// plugins can handle a request
+type NoPluginMatchedError struct {
+	// Operation is the kubeauth operation which was requested
+	Operation string
+	// CredentialType is the type of the provided cloud credential. It is
+	// not set for the operations which are routed by the kubeconfig
+	CredentialType api.CloudCredentialInfo_Type
+}
+
+func (e *NoPluginMatchedError) Error() string {
+	if e.CredentialType == api.CloudCredentialInfo_Invalid {
+		return fmt.Sprintf("no kubeauth plugin supports %v for the auth method of the kubeconfig", e.Operation)
+	}
+	return fmt.Sprintf("no kubeauth plugin supports %v for cloud credential type %v", e.Operation, e.CredentialType)
+}
+
+const (
+	idpIssuerURLKey = "idp-issuer-url"
+)
+
 var (
-	plugins = make(map[string]Plugin)
+	plugins     = make(map[string]PluginV2)
+	descriptors = make(map[string]*Descriptor)
 )
 
-// Register registers the given auth plugin
+// Register registers the given auth plugin without a descriptor.
+// Such plugins are tried in the order of their names after the plugins
+// selected through their descriptors.
+// Deprecated: Use RegisterPlugin instead
 func Register(name string, p Plugin) error {
+	logrus.Infof("Registering auth plugin: %v", name)
+	plugins[name] = NewPluginAdapter(p)
+	delete(descriptors, name)
+	return nil
+}
+
+// RegisterPlugin registers the given auth plugin with the descriptor
+// used for routing the requests to it. Plugins implementing the
+// deprecated Plugin interface can be registered through NewPluginAdapter
+func RegisterPlugin(name string, p PluginV2, d *Descriptor) error {
+	if d == nil {
+		return fmt.Errorf("descriptor not provided for auth plugin %v", name)
+	}
 	logrus.Infof("Registering auth plugin: %v", name)
 	plugins[name] = p
+	descriptors[name] = d
 	return nil
 }
 
 // UpdateClient Updates the k8s client config with the required info
 // from the cloud credential. It will return the new kubeconfig with
-// which the client was updated
+// which the client was updated. A NoPluginMatchedError is returned if no
+// plugin handles the auth method of the provided kubeconfig
 func UpdateClient(
 	conn *grpc.ClientConn,
 	ctx context.Context,
@@ -79,8 +214,8 @@ func UpdateClient(
 	restConfig *rest.Config,
 	clientConfig *clientcmd.Config,
 ) (string, error) {
-	for _, plugin := range plugins {
-		updated, kubeconfig, err := plugin.UpdateClient(conn, ctx, cloudCredentialName, cloudCredentialUID, orgID, restConfig, clientConfig)
+	for _, name := range matchKubeconfig(restConfig) {
+		updated, kubeconfig, err := plugins[name].UpdateClient(ctx, conn, cloudCredentialName, cloudCredentialUID, orgID, restConfig, clientConfig)
 		if err != nil {
 			return "", err
 		}
@@ -88,7 +223,7 @@ func UpdateClient(
 			return kubeconfig, nil
 		}
 	}
-	return "", nil
+	return "", &NoPluginMatchedError{Operation: "UpdateClient"}
 }
 
 // UpdateClientByCredObject Updates the k8s client config with the required info
@@ -98,8 +233,21 @@ func UpdateClientByCredObject(
 	restConfig *rest.Config,
 	clientConfig *clientcmd.Config,
 ) (string, error) {
-	for _, plugin := range plugins {
-		updated, kubeconfig, err := plugin.UpdateClientByCredObject(cloudCred, restConfig, clientConfig)
+	return UpdateClientByCredObjectWithContext(context.Background(), cloudCred, restConfig, clientConfig)
+}
+
+// UpdateClientByCredObjectWithContext Updates the k8s client config with the
+// required info from the provided cloud credential object. The calls made by
+// the plugins are bound to the provided context. A NoPluginMatchedError is
+// returned if no plugin handles the auth method of the provided kubeconfig
+func UpdateClientByCredObjectWithContext(
+	ctx context.Context,
+	cloudCred *api.CloudCredentialObject,
+	restConfig *rest.Config,
+	clientConfig *clientcmd.Config,
+) (string, error) {
+	for _, name := range matchKubeconfig(restConfig) {
+		updated, kubeconfig, err := plugins[name].UpdateClientByCredObject(ctx, cloudCred, restConfig, clientConfig)
 		if err != nil {
 			return "", err
 		}
@@ -107,49 +255,171 @@ func UpdateClientByCredObject(
 			return kubeconfig, nil
 		}
 	}
-	return "", nil
+	return "", &NoPluginMatchedError{Operation: "UpdateClientByCredObject"}
 }
 
 // GetClient gets the k8s client config from the cloud credential
 // The provided cloud credentials should have sufficient
 // permissions to fetch a token using the cloud's SDK APIs
 func GetClient(cloudCred *api.CloudCredentialObject, clusterName string, region string) (*PluginClient, error) {
+	return GetClientWithContext(context.Background(), cloudCred, clusterName, region)
+}
+
+// GetClientWithContext gets the k8s client config from the cloud credential.
+// The calls made by the plugins are bound to the provided context
+func GetClientWithContext(
+	ctx context.Context,
+	cloudCred *api.CloudCredentialObject,
+	clusterName string,
+	region string,
+) (*PluginClient, error) {
+	credType := cloudCred.GetCloudCredentialInfo().GetType()
+	names := matchCredential(credType, func(c Capabilities) bool { return c.SingleLookup })
+	if len(names) == 0 {
+		return nil, &NoPluginMatchedError{Operation: "GetClient", CredentialType: credType}
+	}
 	var getErr error
-	for _, plugin := range plugins {
-		client, err := plugin.GetClient(cloudCred, clusterName, region)
+	for _, name := range names {
+		client, err := plugins[name].GetClient(ctx, cloudCred, clusterName, region)
 		if err == nil {
 			return client, nil
 		}
+		if _, ok := descriptors[name]; ok {
+			// The plugin owns the credential type
+			return nil, err
+		}
 		getErr = multierr.Append(getErr, err)
-
 	}
 	return nil, getErr
-
 }
 
 // GetAllClients gets the k8s client config for all the clusters
 // which the provided cloud credential has access to.
 // The provided cloud credentials should have sufficient
 // permissions to fetch a token using the cloud's SDK APIs
-// TODO: In future API needs to have options for accept needed params for GKE and Azure 
-// cluster scan
 func GetAllClients(
 	cloudCred *api.CloudCredentialObject,
 	maxResult int64,
 	config interface{},
 ) (map[string]*PluginClient, *string, error) {
-	var getErr, err error
-	var nextToken *string
-	var clients map[string]*PluginClient
-	for _, plugin := range plugins {
-		clients, nextToken, err = plugin.GetAllClients(cloudCred, maxResult, config)
+	return GetAllClientsWithContext(context.Background(), cloudCred, maxResult, config)
+}
+
+// GetAllClientsWithContext gets the k8s client config for all the clusters
+// which the provided cloud credential has access to. The calls made by the
+// plugins are bound to the provided context
+func GetAllClientsWithContext(
+	ctx context.Context,
+	cloudCred *api.CloudCredentialObject,
+	maxResult int64,
+	config interface{},
+) (map[string]*PluginClient, *string, error) {
+	credType := cloudCred.GetCloudCredentialInfo().GetType()
+	names := matchCredential(credType, func(c Capabilities) bool { return c.Enumeration })
+	if len(names) == 0 {
+		return nil, nil, &NoPluginMatchedError{Operation: "GetAllClients", CredentialType: credType}
+	}
+	var getErr error
+	for _, name := range names {
+		clients, nextToken, err := plugins[name].GetAllClients(ctx, cloudCred, maxResult, config)
 		if err == nil {
 			return clients, nextToken, nil
 		}
+		if _, ok := descriptors[name]; ok {
+			// The plugin owns the credential type
+			return nil, nil, err
+		}
 		getErr = multierr.Append(getErr, err)
+	}
+	return nil, nil, getErr
+}
+
+// matchKubeconfig returns the names of the plugins which can refresh the
+// provided config followed by the plugins registered without a descriptor
+func matchKubeconfig(restConfig *rest.Config) []string {
+	matched := make([]string, 0)
+	for _, name := range sortedPluginNames() {
+		d, ok := descriptors[name]
+		if !ok {
+			continue
+		}
+		if d.Capabilities.KubeconfigRefresh && d.Matcher.matches(restConfig) {
+			matched = append(matched, name)
+		}
+	}
+	return append(matched, legacyPluginNames()...)
+}
+
+// matchCredential returns the names of the plugins which handle the
+// credential type and have the required capability followed by the
+// plugins registered without a descriptor
+func matchCredential(credType api.CloudCredentialInfo_Type, capable func(Capabilities) bool) []string {
+	matched := make([]string, 0)
+	for _, name := range sortedPluginNames() {
+		d, ok := descriptors[name]
+		if !ok {
+			continue
+		}
+		if d.CredentialType == credType && capable(d.Capabilities) {
+			matched = append(matched, name)
+		}
+	}
+	return append(matched, legacyPluginNames()...)
+}
+
+func legacyPluginNames() []string {
+	names := make([]string, 0)
+	for _, name := range sortedPluginNames() {
+		if _, ok := descriptors[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func sortedPluginNames() []string {
+	names := make([]string, 0, len(plugins))
+	for name := range plugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
 
+func (m *Matcher) matches(restConfig *rest.Config) bool {
+	if restConfig == nil {
+		return false
+	}
+	if restConfig.ExecProvider != nil {
+		for _, execCommand := range m.ExecCommands {
+			if matchExecCommand(execCommand, restConfig.ExecProvider.Command, restConfig.ExecProvider.Args) {
+				return true
+			}
+		}
+	}
+	if restConfig.AuthProvider != nil {
+		for _, authProvider := range m.AuthProviders {
+			if restConfig.AuthProvider.Name == authProvider {
+				return true
+			}
+		}
+		issuerURL := restConfig.AuthProvider.Config[idpIssuerURLKey]
+		for _, issuer := range m.IssuerURLs {
+			if issuerURL != "" && strings.Contains(issuerURL, issuer) {
+				return true
+			}
+		}
+	}
+	if len(m.ServerPathPrefixes) > 0 {
+		if u, err := url.Parse(restConfig.Host); err == nil {
+			for _, prefix := range m.ServerPathPrefixes {
+				if strings.HasPrefix(u.Path, prefix) {
+					return true
+				}
+			}
+		}
 	}
-	return nil, nextToken, getErr
+	return false
 }
 
 // ValidateConfig validates the provided rest config
@@ -166,3 +436,100 @@ func ValidateConfig(restConfig *rest.Config) (bool, error) {
 
 	return false, err
 }
+
+// ValidateConfigWithContext validates the provided rest config. The
+// validation is bound to the provided context
+func ValidateConfigWithContext(ctx context.Context, restConfig *rest.Config) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return ValidateConfig(ConfigWithContext(ctx, restConfig))
+}
+
+// ConfigWithContext returns a copy of the rest config whose requests are
+// bound to the provided context. The timeout of the copy is capped to the
+// deadline of the context. The copy should only be used while the context
+// is alive and should not be returned to the callers of the plugins
+func ConfigWithContext(ctx context.Context, restConfig *rest.Config) *rest.Config {
+	config := rest.CopyConfig(restConfig)
+	if deadline, ok := ctx.Deadline(); ok {
+		if timeout := time.Until(deadline); config.Timeout == 0 || timeout < config.Timeout {
+			config.Timeout = timeout
+		}
+	}
+	config.WrapTransport = transport.Wrappers(config.WrapTransport, func(rt http.RoundTripper) http.RoundTripper {
+		return NewContextRoundTripper(ctx, rt)
+	})
+	return config
+}
+
+// NewContextRoundTripper returns a round tripper which binds all the
+// requests to the provided context. It is meant for the clients of the
+// cloud SDKs which do not take a context
+func NewContextRoundTripper(ctx context.Context, base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &contextRoundTripper{ctx: ctx, base: base}
+}
+
+type contextRoundTripper struct {
+	ctx  context.Context
+	base http.RoundTripper
+}
+
+// RoundTrip sends the request with a context which is done when either the
+// context of the request or the context of the round tripper is done. The
+// merged context is released once the response body is closed
+func (c *contextRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := c.ctx.Err(); err != nil {
+		return nil, err
+	}
+	if c.ctx.Done() == nil {
+		return c.base.RoundTrip(req)
+	}
+	if req.Context().Done() == nil {
+		return c.base.RoundTrip(req.WithContext(c.ctx))
+	}
+	ctx, cancel := context.WithCancel(req.Context())
+	stop := make(chan struct{})
+	go func() {
+		select {
+		case <-c.ctx.Done():
+			cancel()
+		case <-stop:
+		}
+	}()
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			close(stop)
+			cancel()
+		})
+	}
+	resp, err := c.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		release()
+		return nil, err
+	}
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releaseOnClose releases the context of a request once its response body
+// is closed
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (r *releaseOnClose) Close() error {
+	err := r.ReadCloser.Close()
+	r.release()
+	return err
+}
+
+// WrappedRoundTripper returns the wrapped round tripper
+func (c *contextRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return c.base
+}
@@ -0,0 +1,351 @@
+package kubeauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+	"google.golang.org/grpc"
+	"k8s.io/client-go/rest"
+	clientcmd "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// testPlugin records the calls routed to it
+type testPlugin struct {
+	name    string
+	calls   *[]string
+	updated bool
+	err     error
+}
+
+func (p *testPlugin) call(op string) {
+	*p.calls = append(*p.calls, p.name+"."+op)
+}
+
+func (p *testPlugin) UpdateClient(
+	context.Context, *grpc.ClientConn, string, string, string, *rest.Config, *clientcmd.Config,
+) (bool, string, error) {
+	p.call("UpdateClient")
+	return p.updated, p.name, p.err
+}
+
+func (p *testPlugin) UpdateClientByCredObject(
+	context.Context, *api.CloudCredentialObject, *rest.Config, *clientcmd.Config,
+) (bool, string, error) {
+	p.call("UpdateClientByCredObject")
+	return p.updated, p.name, p.err
+}
+
+func (p *testPlugin) GetClient(context.Context, *api.CloudCredentialObject, string, string) (*PluginClient, error) {
+	p.call("GetClient")
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &PluginClient{Uid: p.name}, nil
+}
+
+func (p *testPlugin) GetAllClients(context.Context, *api.CloudCredentialObject, int64, interface{}) (map[string]*PluginClient, *string, error) {
+	p.call("GetAllClients")
+	if p.err != nil {
+		return nil, nil, p.err
+	}
+	return map[string]*PluginClient{p.name: {Uid: p.name}}, nil, nil
+}
+
+// legacyPlugin implements the deprecated Plugin interface
+type legacyPlugin struct {
+	testPlugin
+}
+
+func (p *legacyPlugin) UpdateClient(
+	_ *grpc.ClientConn, ctx context.Context, name string, uid string, orgID string, restConfig *rest.Config, clientConfig *clientcmd.Config,
+) (bool, string, error) {
+	return p.testPlugin.UpdateClient(ctx, nil, name, uid, orgID, restConfig, clientConfig)
+}
+
+func (p *legacyPlugin) UpdateClientByCredObject(
+	cloudCred *api.CloudCredentialObject, restConfig *rest.Config, clientConfig *clientcmd.Config,
+) (bool, string, error) {
+	return p.testPlugin.UpdateClientByCredObject(context.Background(), cloudCred, restConfig, clientConfig)
+}
+
+func (p *legacyPlugin) GetClient(cloudCred *api.CloudCredentialObject, clusterName string, region string) (*PluginClient, error) {
+	return p.testPlugin.GetClient(context.Background(), cloudCred, clusterName, region)
+}
+
+func (p *legacyPlugin) GetAllClients(cloudCred *api.CloudCredentialObject, maxResults int64, config interface{}) (map[string]*PluginClient, *string, error) {
+	return p.testPlugin.GetAllClients(context.Background(), cloudCred, maxResults, config)
+}
+
+var registryLock sync.Mutex
+
+// withRegistry runs the test with an empty plugin registry
+func withRegistry(t *testing.T) *[]string {
+	registryLock.Lock()
+	savedPlugins, savedDescriptors := plugins, descriptors
+	plugins, descriptors = make(map[string]PluginV2), make(map[string]*Descriptor)
+	t.Cleanup(func() {
+		plugins, descriptors = savedPlugins, savedDescriptors
+		registryLock.Unlock()
+	})
+	return &[]string{}
+}
+
+func register(t *testing.T, p *testPlugin, d *Descriptor) {
+	if err := RegisterPlugin(p.name, p, d); err != nil {
+		t.Fatalf("RegisterPlugin failed: %v", err)
+	}
+}
+
+func cloudCredential(credType api.CloudCredentialInfo_Type) *api.CloudCredentialObject {
+	return &api.CloudCredentialObject{CloudCredentialInfo: &api.CloudCredentialInfo{Type: credType}}
+}
+
+func allCapabilities() Capabilities {
+	return Capabilities{KubeconfigRefresh: true, SingleLookup: true, Enumeration: true}
+}
+
+func TestRegisterPluginWithoutDescriptor(t *testing.T) {
+	calls := withRegistry(t)
+	if err := RegisterPlugin("p", &testPlugin{name: "p", calls: calls}, nil); err == nil {
+		t.Errorf("expected a plugin without a descriptor to be rejected")
+	}
+	if len(plugins) != 0 {
+		t.Errorf("expected the plugin not to be registered")
+	}
+}
+
+func TestGetClientRouting(t *testing.T) {
+	calls := withRegistry(t)
+	// Registered out of order, dispatched in the order of the names
+	register(t, &testPlugin{name: "c", calls: calls}, &Descriptor{CredentialType: api.CloudCredentialInfo_Google, Capabilities: allCapabilities()})
+	register(t, &testPlugin{name: "b", calls: calls, err: fmt.Errorf("b failed")},
+		&Descriptor{CredentialType: api.CloudCredentialInfo_Google, Capabilities: allCapabilities()})
+	register(t, &testPlugin{name: "a", calls: calls}, &Descriptor{CredentialType: api.CloudCredentialInfo_Google})
+	register(t, &testPlugin{name: "d", calls: calls}, &Descriptor{CredentialType: api.CloudCredentialInfo_AWS, Capabilities: allCapabilities()})
+
+	for i := 0; i < 10; i++ {
+		*calls = (*calls)[:0]
+		// a has no SingleLookup capability and b owns the credential type,
+		// hence its error is returned without trying c
+		_, err := GetClientWithContext(context.Background(), cloudCredential(api.CloudCredentialInfo_Google), "c1", "")
+		if err == nil || err.Error() != "b failed" {
+			t.Fatalf("expected the error of b, got %v", err)
+		}
+		if strings.Join(*calls, ",") != "b.GetClient" {
+			t.Fatalf("unexpected calls %v", *calls)
+		}
+	}
+
+	*calls = (*calls)[:0]
+	client, err := GetClientWithContext(context.Background(), cloudCredential(api.CloudCredentialInfo_AWS), "c1", "")
+	if err != nil || client.Uid != "d" {
+		t.Errorf("expected the client of d, got %v: %v", client, err)
+	}
+
+	_, err = GetClientWithContext(context.Background(), cloudCredential(api.CloudCredentialInfo_Azure), "c1", "")
+	var noMatch *NoPluginMatchedError
+	if !errors.As(err, &noMatch) || noMatch.Operation != "GetClient" || noMatch.CredentialType != api.CloudCredentialInfo_Azure {
+		t.Errorf("expected a NoPluginMatchedError, got %v", err)
+	}
+}
+
+func TestGetAllClientsRouting(t *testing.T) {
+	calls := withRegistry(t)
+	register(t, &testPlugin{name: "a", calls: calls},
+		&Descriptor{CredentialType: api.CloudCredentialInfo_IBM, Capabilities: Capabilities{SingleLookup: true}})
+
+	_, _, err := GetAllClientsWithContext(context.Background(), cloudCredential(api.CloudCredentialInfo_IBM), 0, nil)
+	var noMatch *NoPluginMatchedError
+	if !errors.As(err, &noMatch) || noMatch.Operation != "GetAllClients" {
+		t.Fatalf("expected a NoPluginMatchedError, got %v", err)
+	}
+
+	// The legacy plugins are tried after the plugins with a descriptor
+	// and their errors are combined
+	if err := Register("z", &legacyPlugin{testPlugin{name: "z", calls: calls, err: fmt.Errorf("z failed")}}); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	if err := Register("y", &legacyPlugin{testPlugin{name: "y", calls: calls, err: fmt.Errorf("y failed")}}); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	register(t, &testPlugin{name: "b", calls: calls, err: fmt.Errorf("b failed")},
+		&Descriptor{CredentialType: api.CloudCredentialInfo_AWS, Capabilities: allCapabilities()})
+	_, _, err = GetAllClientsWithContext(context.Background(), cloudCredential(api.CloudCredentialInfo_IBM), 0, nil)
+	if err == nil || !strings.Contains(err.Error(), "y failed") || !strings.Contains(err.Error(), "z failed") {
+		t.Errorf("expected the errors of y and z, got %v", err)
+	}
+	if strings.Join(*calls, ",") != "y.GetAllClients,z.GetAllClients" {
+		t.Errorf("unexpected calls %v", *calls)
+	}
+}
+
+func TestUpdateClientRouting(t *testing.T) {
+	calls := withRegistry(t)
+	register(t, &testPlugin{name: "exec", calls: calls, updated: true}, &Descriptor{
+		Matcher:      Matcher{ExecCommands: []string{"kubectl oidc-login", "aws-iam-authenticator"}},
+		Capabilities: allCapabilities(),
+	})
+	register(t, &testPlugin{name: "provider", calls: calls, updated: true}, &Descriptor{
+		Matcher:      Matcher{AuthProviders: []string{"gcp"}, IssuerURLs: []string{"iam.cloud.ibm.com"}},
+		Capabilities: allCapabilities(),
+	})
+	register(t, &testPlugin{name: "server", calls: calls, updated: true}, &Descriptor{
+		Matcher:      Matcher{ServerPathPrefixes: []string{"/k8s/clusters/"}},
+		Capabilities: allCapabilities(),
+	})
+	register(t, &testPlugin{name: "readonly", calls: calls, updated: true}, &Descriptor{
+		Matcher:      Matcher{AuthProviders: []string{"oidc"}},
+		Capabilities: Capabilities{Enumeration: true},
+	})
+
+	tests := []struct {
+		name     string
+		config   *rest.Config
+		expected string
+	}{
+		{
+			name:     "exec command and first arg",
+			config:   &rest.Config{ExecProvider: &clientcmd.ExecConfig{Command: "/usr/local/bin/kubectl", Args: []string{"oidc-login", "get-token"}}},
+			expected: "exec",
+		},
+		{
+			name:   "generic launcher",
+			config: &rest.Config{ExecProvider: &clientcmd.ExecConfig{Command: "kubectl", Args: []string{"other-plugin"}}},
+		},
+		{
+			name:     "exec command",
+			config:   &rest.Config{ExecProvider: &clientcmd.ExecConfig{Command: "aws-iam-authenticator"}},
+			expected: "exec",
+		},
+		{
+			name:     "auth provider",
+			config:   &rest.Config{AuthProvider: &clientcmd.AuthProviderConfig{Name: "gcp"}},
+			expected: "provider",
+		},
+		{
+			name: "issuer url",
+			config: &rest.Config{AuthProvider: &clientcmd.AuthProviderConfig{
+				Name:   "oidc",
+				Config: map[string]string{idpIssuerURLKey: "https://iam.cloud.ibm.com/identity"},
+			}},
+			expected: "provider",
+		},
+		{
+			name:     "server path",
+			config:   &rest.Config{Host: "https://rancher.example.com/k8s/clusters/c-1"},
+			expected: "server",
+		},
+		{
+			name:   "plugin without KubeconfigRefresh",
+			config: &rest.Config{AuthProvider: &clientcmd.AuthProviderConfig{Name: "oidc"}},
+		},
+		{
+			name:   "no auth method",
+			config: &rest.Config{Host: "https://example.com", BearerToken: "token"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kubeconfig, err := UpdateClientByCredObjectWithContext(context.Background(), nil, test.config, nil)
+			if test.expected == "" {
+				var noMatch *NoPluginMatchedError
+				if !errors.As(err, &noMatch) || noMatch.Operation != "UpdateClientByCredObject" {
+					t.Errorf("expected a NoPluginMatchedError, got %v, %v", kubeconfig, err)
+				}
+			} else if err != nil || kubeconfig != test.expected {
+				t.Errorf("expected the update by %v, got %v, %v", test.expected, kubeconfig, err)
+			}
+
+			kubeconfig, err = UpdateClient(nil, context.Background(), "", "", "", test.config, nil)
+			if test.expected == "" {
+				var noMatch *NoPluginMatchedError
+				if !errors.As(err, &noMatch) || noMatch.Operation != "UpdateClient" {
+					t.Errorf("expected a NoPluginMatchedError, got %v, %v", kubeconfig, err)
+				}
+			} else if err != nil || kubeconfig != test.expected {
+				t.Errorf("expected the update by %v, got %v, %v", test.expected, kubeconfig, err)
+			}
+		})
+	}
+}
+
+func TestUpdateClientNotUpdated(t *testing.T) {
+	calls := withRegistry(t)
+	register(t, &testPlugin{name: "a", calls: calls}, &Descriptor{
+		Matcher:      Matcher{AuthProviders: []string{"gcp"}},
+		Capabilities: allCapabilities(),
+	})
+	config := &rest.Config{AuthProvider: &clientcmd.AuthProviderConfig{Name: "gcp"}}
+
+	// A matched plugin which leaves the config as is does not handle it
+	_, err := UpdateClientByCredObjectWithContext(context.Background(), nil, config, nil)
+	var noMatch *NoPluginMatchedError
+	if !errors.As(err, &noMatch) {
+		t.Errorf("expected a NoPluginMatchedError, got %v", err)
+	}
+	if strings.Join(*calls, ",") != "a.UpdateClientByCredObject" {
+		t.Errorf("unexpected calls %v", *calls)
+	}
+}
+
+func TestContextRoundTripper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/block" {
+			<-r.Context().Done()
+			return
+		}
+		_, _ = io.WriteString(w, "ok")
+	}))
+	defer server.Close()
+
+	get := func(rt http.RoundTripper, reqCtx context.Context, path string) (string, error) {
+		req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, server.URL+path, nil)
+		if err != nil {
+			return "", err
+		}
+		resp, err := rt.RoundTrip(req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		return string(body), err
+	}
+
+	// The body can be read after the round trip returns
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reqCtx, reqCancel := context.WithCancel(context.Background())
+	defer reqCancel()
+	if body, err := get(NewContextRoundTripper(ctx, nil), reqCtx, "/"); err != nil || body != "ok" {
+		t.Errorf("unexpected response %q: %v", body, err)
+	}
+
+	// The request is cancelled with the context of the request
+	reqCtx, reqCancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer reqCancel()
+	if _, err := get(NewContextRoundTripper(ctx, nil), reqCtx, "/block"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the request to time out, got %v", err)
+	}
+
+	// The request is cancelled with the context of the round tripper
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	reqCtx, reqCancel = context.WithCancel(context.Background())
+	defer reqCancel()
+	if _, err := get(NewContextRoundTripper(ctx, nil), reqCtx, "/block"); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the request to be cancelled, got %v", err)
+	}
+	if _, err := get(NewContextRoundTripper(ctx, nil), context.Background(), "/"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the context error, got %v", err)
+	}
+}
@@ -0,0 +1,399 @@
+package oidc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+	"github.com/portworx/px-backup-api/pkg/kubeauth"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	"google.golang.org/grpc"
+	"k8s.io/client-go/rest"
+	clientcmd "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	pluginName = "oidc"
+	// idpIssuerURLKey is the issuer url in the oidc auth provider config
+	idpIssuerURLKey = "idp-issuer-url"
+	// issuerURLArg is the issuer url flag of the oidc-login exec plugin
+	issuerURLArg = "--oidc-issuer-url"
+	// oidcLoginCommand is the exec command of the oidc-login plugin, which
+	// can also be run as a kubectl subcommand
+	oidcLoginCommand    = "kubectl-oidc_login"
+	oidcLoginSubcommand = "oidc-login"
+	kubeloginCommand    = "kubelogin"
+	kubectlCommand      = "kubectl"
+	discoveryPath       = "/.well-known/openid-configuration"
+	idTokenKey          = "id_token"
+	openIDScope         = "openid"
+	// httpTimeout bounds the requests made to the identity provider
+	httpTimeout = 30 * time.Second
+	// maxDiscoverySize is the maximum size of the discovery document
+	maxDiscoverySize = 1 << 20
+	// defaultTokenLifetime is used if the expiry of a token
+	// cannot be determined
+	defaultTokenLifetime = 5 * time.Minute
+)
+
+type oidc struct {
+}
+
+// providerMetadata is the subset of the OpenID provider metadata
+// used by this plugin
+type providerMetadata struct {
+	Issuer        string `json:"issuer"`
+	TokenEndpoint string `json:"token_endpoint"`
+}
+
+// tokenMinter mints the ID tokens for an OIDC cloud credential. It keeps
+// the token endpoint once discovered and the latest refresh token, as the
+// identity providers may rotate the refresh token on every use. The rotated
+// refresh token is only kept in memory and not written back to the cloud
+// credential, hence after a restart the refresh token of the cloud
+// credential is used again. Identity providers which revoke a rotated
+// refresh token once it is replaced need the client credentials grant, or
+// the cloud credential to be updated with a new refresh token
+type tokenMinter struct {
+	config     *api.OIDCConfig
+	httpClient *http.Client
+
+	lock          sync.Mutex
+	tokenEndpoint string
+	refreshToken  string
+}
+
+// Init initializes the oidc auth plugin
+func (o *oidc) Init() error {
+	return nil
+}
+
+func (o *oidc) UpdateClient(
+	ctx context.Context,
+	conn *grpc.ClientConn,
+	cloudCredentialName string,
+	cloudCredentialUID string,
+	orgID string,
+	client *rest.Config,
+	clientConfig *clientcmd.Config,
+) (bool, string, error) {
+	// OIDC does not support returning kubeconfigs. The kubeconfig is left
+	// as is so that its issuer can be matched on the next refresh
+	var emptyKubeconfig string
+	issuerURL := getIssuerURL(client)
+	if issuerURL == "" {
+		// not an oidc kubeauth provider
+		return false, emptyKubeconfig, nil
+	}
+	if cloudCredentialName == "" {
+		// The kubeconfig is refreshed by its own oidc-login plugin
+		return false, emptyKubeconfig, nil
+	}
+	cloudCredentialClient := api.NewCloudCredentialClient(conn)
+	resp, err := cloudCredentialClient.Inspect(
+		ctx,
+		&api.CloudCredentialInspectRequest{
+			Name:           cloudCredentialName,
+			Uid:            cloudCredentialUID,
+			OrgId:          orgID,
+			IncludeSecrets: true,
+		},
+	)
+	if err != nil {
+		return false, emptyKubeconfig, err
+	}
+	updated, err := o.updateClient(ctx, resp.GetCloudCredential(), issuerURL, client)
+	return updated, emptyKubeconfig, err
+}
+
+func (o *oidc) UpdateClientByCredObject(
+	ctx context.Context,
+	cloudCred *api.CloudCredentialObject,
+	client *rest.Config,
+	clientConfig *clientcmd.Config,
+) (bool, string, error) {
+	// OIDC does not support returning kubeconfigs
+	var emptyKubeconfig string
+	issuerURL := getIssuerURL(client)
+	if issuerURL == "" {
+		// not an oidc kubeauth provider
+		return false, emptyKubeconfig, nil
+	}
+	if cloudCred == nil {
+		// The kubeconfig is refreshed by its own oidc-login plugin
+		return false, emptyKubeconfig, nil
+	}
+	updated, err := o.updateClient(ctx, cloudCred, issuerURL, client)
+	return updated, emptyKubeconfig, err
+}
+
+func (o *oidc) GetClient(
+	_ context.Context,
+	_ *api.CloudCredentialObject,
+	_ string,
+	_ string,
+) (*kubeauth.PluginClient, error) {
+	return nil, fmt.Errorf("GetClient is not supported for OIDC cloud credentials")
+}
+
+func (o *oidc) GetAllClients(
+	_ context.Context,
+	_ *api.CloudCredentialObject,
+	_ int64,
+	_ interface{},
+) (map[string]*kubeauth.PluginClient, *string, error) {
+	return nil, nil, fmt.Errorf("GetAllClients is not supported for OIDC cloud credentials")
+}
+
+// updateClient replaces the auth method of the rest client with the ID
+// tokens minted for the cloud credential. The rest client is left as is
+// if the cloud credential is not for the issuer of the kubeconfig
+func (o *oidc) updateClient(
+	ctx context.Context,
+	cloudCred *api.CloudCredentialObject,
+	issuerURL string,
+	client *rest.Config,
+) (bool, error) {
+	if cloudCred.GetCloudCredentialInfo().GetType() != api.CloudCredentialInfo_OIDC {
+		return false, nil
+	}
+	oidcConfig := cloudCred.GetCloudCredentialInfo().GetOidcConfig()
+	if normalizeIssuer(oidcConfig.GetIssuerUrl()) != normalizeIssuer(issuerURL) {
+		return false, nil
+	}
+	if oidcConfig.GetClientId() == "" {
+		return false, fmt.Errorf("client_id is required in the OIDC CloudCredential")
+	}
+	if oidcConfig.GetClientSecret() == "" && oidcConfig.GetRefreshToken() == "" {
+		return false, fmt.Errorf("client_secret or refresh_token is required in the OIDC CloudCredential")
+	}
+	tokenSource, err := getTokenSource(oidcConfig)
+	if err != nil {
+		return false, err
+	}
+	// Mint the first token upfront so that bad credentials are reported
+	// here instead of on the first request made with the client
+	if _, err := tokenSource.Token(ctx); err != nil {
+		return false, err
+	}
+
+	client.AuthProvider = nil
+	client.ExecProvider = nil
+	client.BearerToken = ""
+	client.BearerTokenFile = ""
+	// The transport wrapper is replaced rather than chained, as the same
+	// rest client is refreshed again when the tokens expire
+	client.WrapTransport = tokenSource.WrapTransport()
+	return true, nil
+}
+
+// getTokenSource returns the cached token source for the cloud credential.
+// The secrets are its fingerprint so that an updated cloud credential
+// replaces the token minter of the cached token source
+func getTokenSource(oidcConfig *api.OIDCConfig) (*kubeauth.CachedTokenSource, error) {
+	httpClient, err := newHTTPClient(oidcConfig.GetCaBundle())
+	if err != nil {
+		return nil, err
+	}
+	fingerprint := kubeauth.TokenFingerprint(
+		oidcConfig.GetClientSecret(),
+		oidcConfig.GetRefreshToken(),
+		strings.Join(oidcConfig.GetScopes(), " "),
+		oidcConfig.GetCaBundle(),
+	)
+	minter := &tokenMinter{
+		config:       oidcConfig,
+		httpClient:   httpClient,
+		refreshToken: oidcConfig.GetRefreshToken(),
+	}
+	key := pluginName + "/" + normalizeIssuer(oidcConfig.GetIssuerUrl()) + "/" + oidcConfig.GetClientId()
+	return kubeauth.GetCachedTokenSource(key, fingerprint, minter.mint), nil
+}
+
+// mint returns a new ID token through the refresh token grant if the
+// cloud credential has a refresh token, else through the client
+// credentials grant. The access token is returned if the identity
+// provider does not issue an ID token
+func (t *tokenMinter) mint(ctx context.Context) (string, time.Time, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	// The requests to the identity provider are bound to the request
+	// the token is minted for
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, t.httpClient)
+	if t.tokenEndpoint == "" {
+		tokenEndpoint, err := discoverTokenEndpoint(ctx, t.httpClient, t.config.GetIssuerUrl())
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		t.tokenEndpoint = tokenEndpoint
+	}
+
+	scopes := withOpenIDScope(t.config.GetScopes())
+	var token *oauth2.Token
+	var err error
+	if t.refreshToken != "" {
+		config := &oauth2.Config{
+			ClientID:     t.config.GetClientId(),
+			ClientSecret: t.config.GetClientSecret(),
+			Endpoint:     oauth2.Endpoint{TokenURL: t.tokenEndpoint},
+			Scopes:       scopes,
+		}
+		token, err = config.TokenSource(ctx, &oauth2.Token{RefreshToken: t.refreshToken}).Token()
+		if err == nil && token.RefreshToken != "" {
+			t.refreshToken = token.RefreshToken
+		}
+	} else {
+		config := &clientcredentials.Config{
+			ClientID:     t.config.GetClientId(),
+			ClientSecret: t.config.GetClientSecret(),
+			TokenURL:     t.tokenEndpoint,
+			Scopes:       scopes,
+		}
+		token, err = config.Token(ctx)
+	}
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to get token from %v: %v", t.tokenEndpoint, err)
+	}
+
+	if idToken, ok := token.Extra(idTokenKey).(string); ok && idToken != "" {
+		return idToken, tokenExpiry(idToken, token.Expiry), nil
+	}
+	if token.AccessToken == "" {
+		return "", time.Time{}, fmt.Errorf("no token returned by %v", t.tokenEndpoint)
+	}
+	return token.AccessToken, tokenExpiry(token.AccessToken, token.Expiry), nil
+}
+
+// discoverTokenEndpoint returns the token endpoint from the OpenID
+// provider metadata of the issuer
+func discoverTokenEndpoint(ctx context.Context, httpClient *http.Client, issuerURL string) (string, error) {
+	discoveryURL := normalizeIssuer(issuerURL) + discoveryPath
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the OpenID provider metadata of %v: %v", issuerURL, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxDiscoverySize))
+	if err != nil {
+		return "", fmt.Errorf("failed to read the OpenID provider metadata of %v: %v", issuerURL, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get the OpenID provider metadata of %v: %v: %s", issuerURL, resp.Status, body)
+	}
+	metadata := &providerMetadata{}
+	if err := json.Unmarshal(body, metadata); err != nil {
+		return "", fmt.Errorf("failed to parse the OpenID provider metadata of %v: %v", issuerURL, err)
+	}
+	if normalizeIssuer(metadata.Issuer) != normalizeIssuer(issuerURL) {
+		return "", fmt.Errorf("issuer %v in the OpenID provider metadata does not match %v", metadata.Issuer, issuerURL)
+	}
+	if metadata.TokenEndpoint == "" {
+		return "", fmt.Errorf("token_endpoint not found in the OpenID provider metadata of %v", issuerURL)
+	}
+	return metadata.TokenEndpoint, nil
+}
+
+// newHTTPClient returns the client for the requests made to the identity
+// provider, trusting the CA bundle in addition to the system CAs
+func newHTTPClient(caBundle string) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if caBundle != "" {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM([]byte(caBundle)) {
+			return nil, fmt.Errorf("failed to parse the ca_bundle of the OIDC CloudCredential")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs}
+	}
+	return &http.Client{
+		Transport: transport,
+		Timeout:   httpTimeout,
+	}, nil
+}
+
+// getIssuerURL returns the issuer url of the oidc auth provider or of
+// the oidc-login exec plugin of the rest client, if any
+func getIssuerURL(client *rest.Config) string {
+	if client == nil {
+		return ""
+	}
+	if client.AuthProvider != nil && client.AuthProvider.Name == pluginName {
+		return client.AuthProvider.Config[idpIssuerURLKey]
+	}
+	if client.ExecProvider != nil {
+		args := client.ExecProvider.Args
+		switch path.Base(client.ExecProvider.Command) {
+		case oidcLoginCommand, kubeloginCommand:
+			return kubeauth.ExecArg(args, issuerURLArg, "")
+		case kubectlCommand:
+			if len(args) > 0 && args[0] == oidcLoginSubcommand {
+				return kubeauth.ExecArg(args, issuerURLArg, "")
+			}
+		}
+	}
+	return ""
+}
+
+func normalizeIssuer(issuerURL string) string {
+	return strings.TrimSuffix(strings.TrimSpace(issuerURL), "/")
+}
+
+func withOpenIDScope(scopes []string) []string {
+	for _, scope := range scopes {
+		if scope == openIDScope {
+			return scopes
+		}
+	}
+	return append([]string{openIDScope}, scopes...)
+}
+
+// tokenExpiry returns the expiry from the claims of the token if it is a
+// JWT, else the expiry returned by the identity provider
+func tokenExpiry(token string, expiry time.Time) time.Time {
+	if claimed := kubeauth.TokenExpiry(token); claimed != nil {
+		return *claimed
+	}
+	if !expiry.IsZero() {
+		return expiry
+	}
+	return time.Now().Add(defaultTokenLifetime)
+}
+
+func init() {
+	o := &oidc{}
+	descriptor := &kubeauth.Descriptor{
+		CredentialType: api.CloudCredentialInfo_OIDC,
+		Matcher: kubeauth.Matcher{
+			ExecCommands:  []string{oidcLoginCommand, kubeloginCommand, kubectlCommand + " " + oidcLoginSubcommand},
+			AuthProviders: []string{pluginName},
+		},
+		Capabilities: kubeauth.Capabilities{
+			KubeconfigRefresh: true,
+		},
+		AllowedExecCommands: []string{
+			oidcLoginCommand + " get-token",
+			kubeloginCommand + " get-token",
+			kubectlCommand + " " + oidcLoginSubcommand + " get-token",
+		},
+	}
+	if err := kubeauth.RegisterPlugin(pluginName, o, descriptor); err != nil {
+		logrus.Panicf("Error registering oidc auth plugin: %v", err)
+	}
+}
@@ -0,0 +1,193 @@
+package oidc_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+	"github.com/portworx/px-backup-api/pkg/kubeauth"
+	"github.com/portworx/px-backup-api/pkg/kubeauth/fake"
+	_ "github.com/portworx/px-backup-api/pkg/kubeauth/oidc"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	testClientID     = "px-backup"
+	testClientSecret = "secret"
+	testRefreshToken = "refresh-token"
+)
+
+func newFakes(t *testing.T) (*fake.OIDC, *fake.APIServer) {
+	provider := fake.NewOIDC(testClientID, testClientSecret, testRefreshToken)
+	server := fake.NewAPIServer("v1.29.1")
+	t.Cleanup(func() {
+		provider.Close()
+		server.Close()
+	})
+	return provider, server
+}
+
+func newCloudCredential(provider *fake.OIDC, clientSecret string, refreshToken string) *api.CloudCredentialObject {
+	return fake.NewCloudCredential(&api.OIDCConfig{
+		IssuerUrl:    provider.URL() + "/",
+		ClientId:     testClientID,
+		ClientSecret: clientSecret,
+		RefreshToken: refreshToken,
+		CaBundle:     string(provider.CAData()),
+	})
+}
+
+// newClient returns the rest config and the kubeconfig of a cluster
+// authenticated by the given user
+func newClient(t *testing.T, server *fake.APIServer, user string) (*rest.Config, *clientcmdapi.Config) {
+	clientConfig, err := clientcmd.Load([]byte(fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: oidc
+clusters:
+- name: oidc
+  cluster:
+    server: %s
+    certificate-authority-data: %s
+contexts:
+- name: oidc
+  context:
+    cluster: oidc
+    user: oidc
+users:
+- name: oidc
+  user:
+%s`, server.URL, encode(server.CAData()), user)))
+	if err != nil {
+		t.Fatalf("failed to load the kubeconfig: %v", err)
+	}
+	restConfig, err := clientcmd.NewDefaultClientConfig(*clientConfig, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		t.Fatalf("failed to build the rest config: %v", err)
+	}
+	return restConfig, clientConfig
+}
+
+func execUser(issuerURL string) string {
+	return fmt.Sprintf(`    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: kubectl
+      args:
+      - oidc-login
+      - get-token
+      - --oidc-issuer-url=%s
+      - --oidc-client-id=%s
+`, issuerURL, testClientID)
+}
+
+func authProviderUser(issuerURL string) string {
+	return fmt.Sprintf(`    auth-provider:
+      name: oidc
+      config:
+        idp-issuer-url: %s
+        client-id: %s
+`, issuerURL, testClientID)
+}
+
+func TestUpdateClientByCredObject(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		user func(string) string
+	}{
+		{name: "exec", user: execUser},
+		{name: "auth-provider", user: authProviderUser},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			provider, server := newFakes(t)
+			restConfig, clientConfig := newClient(t, server, test.user(provider.URL()))
+
+			_, err := kubeauth.UpdateClientByCredObjectWithContext(
+				context.Background(), newCloudCredential(provider, testClientSecret, ""), restConfig, clientConfig)
+			if err != nil {
+				t.Fatalf("UpdateClientByCredObjectWithContext failed: %v", err)
+			}
+			if restConfig.ExecProvider != nil || restConfig.AuthProvider != nil {
+				t.Errorf("expected the auth method to be replaced")
+			}
+			fake.CheckClient(t, restConfig, server)
+			if server.LastToken() == "" {
+				t.Errorf("expected the request to carry an ID token")
+			}
+		})
+	}
+}
+
+func TestUpdateClientByCredObjectOtherIssuer(t *testing.T) {
+	provider, server := newFakes(t)
+	restConfig, clientConfig := newClient(t, server, execUser("https://other.example.com"))
+
+	_, err := kubeauth.UpdateClientByCredObjectWithContext(
+		context.Background(), newCloudCredential(provider, testClientSecret, ""), restConfig, clientConfig)
+	var noMatch *kubeauth.NoPluginMatchedError
+	if !errors.As(err, &noMatch) {
+		t.Fatalf("expected no plugin to match, got %v", err)
+	}
+	if restConfig.ExecProvider == nil {
+		t.Errorf("expected the client of another issuer to be left as is")
+	}
+	if provider.IssuedTokens() != 0 {
+		t.Errorf("expected no token to be issued")
+	}
+}
+
+func TestUpdateClientByCredObjectInvalidSecret(t *testing.T) {
+	provider, server := newFakes(t)
+	restConfig, clientConfig := newClient(t, server, execUser(provider.URL()))
+
+	if _, err := kubeauth.UpdateClientByCredObjectWithContext(
+		context.Background(), newCloudCredential(provider, "wrong", ""), restConfig, clientConfig); err == nil {
+		t.Errorf("expected an invalid client secret to fail")
+	}
+}
+
+func TestUpdateClientByCredObjectContextDone(t *testing.T) {
+	provider, server := newFakes(t)
+	restConfig, clientConfig := newClient(t, server, execUser(provider.URL()))
+
+	// The token is minted with the context of the update
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := kubeauth.UpdateClientByCredObjectWithContext(
+		ctx, newCloudCredential(provider, testClientSecret, ""), restConfig, clientConfig)
+	if err == nil || !strings.Contains(err.Error(), context.Canceled.Error()) {
+		t.Errorf("expected the context error, got %v", err)
+	}
+	if provider.IssuedTokens() != 0 {
+		t.Errorf("expected no token to be issued")
+	}
+}
+
+func TestRotatedRefreshToken(t *testing.T) {
+	provider, server := newFakes(t)
+	// The tokens expire within the refresh window, so that a new one is
+	// minted for every request with the latest refresh token
+	provider.SetTokenLifetime(time.Second)
+	provider.SetRotateRefreshTokens(true)
+	restConfig, clientConfig := newClient(t, server, execUser(provider.URL()))
+
+	if _, err := kubeauth.UpdateClientByCredObjectWithContext(
+		context.Background(), newCloudCredential(provider, "", testRefreshToken), restConfig, clientConfig); err != nil {
+		t.Fatalf("UpdateClientByCredObjectWithContext failed: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		fake.ServerVersion(t, restConfig)
+	}
+	if issued := provider.IssuedTokens(); issued < 4 {
+		t.Errorf("expected a token to be minted for every request, got %v tokens", issued)
+	}
+}
+
+func encode(data []byte) string {
+	return base64.StdEncoding.EncodeToString(data)
+}
@@ -0,0 +1,448 @@
+package kubeauth
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// Severity is the severity of a kubeconfig policy finding
+type Severity string
+
+const (
+	// SeverityError is reported for the kubeconfigs which should be rejected
+	SeverityError Severity = "Error"
+	// SeverityWarning is reported for the kubeconfigs which can be
+	// accepted but are not recommended
+	SeverityWarning Severity = "Warning"
+)
+
+// Rule identifies the kubeconfig policy rule a finding is reported for
+type Rule string
+
+const (
+	// RuleExecCommand is reported for exec credential plugins whose
+	// command, or whose leading args, are not allowed
+	RuleExecCommand Rule = "ExecCommand"
+	// RuleExecEnv is reported for exec credential plugins which set the
+	// environment variables changing which binaries or libraries are
+	// loaded, like PATH or LD_PRELOAD
+	RuleExecEnv Rule = "ExecEnv"
+	// RuleAuthProvider is reported for auth providers which are not allowed
+	RuleAuthProvider Rule = "AuthProvider"
+	// RuleInsecureSkipTLSVerify is reported for clusters which skip the
+	// verification of the server certificate
+	RuleInsecureSkipTLSVerify Rule = "InsecureSkipTLSVerify"
+	// RuleProxyURL is reported for clusters whose requests, along with
+	// their credentials, are sent through a proxy
+	RuleProxyURL Rule = "ProxyURL"
+	// RuleFilePath is reported for certificates, keys and tokens which are
+	// read from files, and for the exec args and environment variables
+	// whose value is a file path, as they would be read from the server
+	// filesystem
+	RuleFilePath Rule = "FilePath"
+)
+
+// genericLaunchers are the commands which run arbitrary programs. They are
+// only allowed by the default policy along with required leading args
+var genericLaunchers = map[string]bool{
+	"kubectl": true,
+	"env":     true,
+	"sh":      true,
+	"bash":    true,
+	"zsh":     true,
+	"python":  true,
+	"python3": true,
+	"node":    true,
+	"sudo":    true,
+	"xargs":   true,
+}
+
+// deniedExecEnvs are the environment variables which change the binaries
+// or libraries loaded by the exec credential plugins. deniedExecEnvPrefixes
+// are the prefixes of such variables
+var (
+	deniedExecEnvs = map[string]bool{
+		"PATH":          true,
+		"BASH_ENV":      true,
+		"ENV":           true,
+		"IFS":           true,
+		"PYTHONPATH":    true,
+		"PYTHONSTARTUP": true,
+		"PYTHONHOME":    true,
+		"NODE_OPTIONS":  true,
+		"NODE_PATH":     true,
+		"PERL5LIB":      true,
+		"PERL5OPT":      true,
+		"RUBYOPT":       true,
+		"RUBYLIB":       true,
+	}
+	deniedExecEnvPrefixes = []string{"LD_", "DYLD_"}
+)
+
+// Finding is a violation of the kubeconfig policy
+type Finding struct {
+	// Rule which was violated
+	Rule Rule
+	// Severity of the violation
+	Severity Severity
+	// Field is the path of the offending field in the kubeconfig,
+	// for example users[admin].exec.command
+	Field string
+	// Message describes the violation
+	Message string
+}
+
+func (f *Finding) String() string {
+	return fmt.Sprintf("%v: %v: %v", f.Severity, f.Field, f.Message)
+}
+
+// Findings are the violations found in a kubeconfig
+type Findings []*Finding
+
+// Errors returns the findings with the error severity
+func (f Findings) Errors() Findings {
+	return f.withSeverity(SeverityError)
+}
+
+// Warnings returns the findings with the warning severity
+func (f Findings) Warnings() Findings {
+	return f.withSeverity(SeverityWarning)
+}
+
+// Err returns an error listing the findings with the error severity,
+// or nil if there are none
+func (f Findings) Err() error {
+	errs := f.Errors()
+	if len(errs) == 0 {
+		return nil
+	}
+	messages := make([]string, 0, len(errs))
+	for _, finding := range errs {
+		messages = append(messages, finding.Field+": "+finding.Message)
+	}
+	return fmt.Errorf("kubeconfig violates the security policy: %v", strings.Join(messages, "; "))
+}
+
+func (f Findings) withSeverity(severity Severity) Findings {
+	filtered := make(Findings, 0)
+	for _, finding := range f {
+		if finding.Severity == severity {
+			filtered = append(filtered, finding)
+		}
+	}
+	return filtered
+}
+
+// KubeconfigPolicy is the policy the kubeconfigs of the clusters are
+// checked against before they are used by the server
+type KubeconfigPolicy struct {
+	// AllowedExecCommands are the exec credential plugin commands which
+	// may be run. An entry without a directory only allows the command
+	// to be run from the PATH, while an entry with a directory only allows
+	// that exact path. An entry of the form "<command> <args>", like
+	// "aws eks get-token", also requires the exec args to start with the
+	// given args
+	AllowedExecCommands []string
+	// AllowedAuthProviders are the auth provider names which may be used
+	AllowedAuthProviders []string
+	// AllowInsecureSkipTLSVerify allows the clusters to skip the
+	// verification of the server certificate. A warning is reported
+	// for them otherwise
+	AllowInsecureSkipTLSVerify bool
+	// AllowProxyURL allows the clusters to send their requests through
+	// an http, https or socks5 proxy. An error is reported for them
+	// otherwise
+	AllowProxyURL bool
+	// AllowFilePaths allows the certificates, keys and tokens to be read
+	// from files, and the exec args and environment variables to be file
+	// paths. An error is reported for them otherwise
+	AllowFilePaths bool
+}
+
+// DefaultKubeconfigPolicy returns the policy which only allows the exec
+// commands, with their leading args, and the auth providers handled by the
+// registered plugins. The generic launchers like kubectl or sh are left out
+// unless a plugin allows them with leading args, like
+// "kubectl oidc-login get-token"
+func DefaultKubeconfigPolicy() *KubeconfigPolicy {
+	policy := &KubeconfigPolicy{}
+	execCommands := make(map[string]bool)
+	authProviders := make(map[string]bool)
+	for _, name := range sortedPluginNames() {
+		d, ok := descriptors[name]
+		if !ok || !d.Capabilities.KubeconfigRefresh {
+			continue
+		}
+		for _, command := range d.AllowedExecCommands {
+			if genericLaunchers[command] {
+				continue
+			}
+			if !execCommands[command] {
+				execCommands[command] = true
+				policy.AllowedExecCommands = append(policy.AllowedExecCommands, command)
+			}
+		}
+		for _, authProvider := range d.Matcher.AuthProviders {
+			if !authProviders[authProvider] {
+				authProviders[authProvider] = true
+				policy.AllowedAuthProviders = append(policy.AllowedAuthProviders, authProvider)
+			}
+		}
+	}
+	return policy
+}
+
+// CheckKubeconfig parses the kubeconfig and returns its violations of the
+// policy. The default policy is used if none is provided. All the clusters
+// and users of the kubeconfig are checked, not only the current context
+func CheckKubeconfig(kubeconfig string, policy *KubeconfigPolicy) (Findings, error) {
+	config, err := clientcmd.Load([]byte(kubeconfig))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig: %v", err)
+	}
+	return CheckConfig(config, policy), nil
+}
+
+// CheckConfig returns the violations of the policy by the parsed
+// kubeconfig. The default policy is used if none is provided
+func CheckConfig(config *clientcmdapi.Config, policy *KubeconfigPolicy) Findings {
+	if policy == nil {
+		policy = DefaultKubeconfigPolicy()
+	}
+	findings := make(Findings, 0)
+	if config == nil {
+		return findings
+	}
+	for _, name := range sortedKeys(config.Clusters) {
+		findings = append(findings, policy.checkCluster(name, config.Clusters[name])...)
+	}
+	for _, name := range sortedKeys(config.AuthInfos) {
+		findings = append(findings, policy.checkAuthInfo(name, config.AuthInfos[name])...)
+	}
+	return findings
+}
+
+func (p *KubeconfigPolicy) checkCluster(name string, cluster *clientcmdapi.Cluster) Findings {
+	findings := make(Findings, 0)
+	if cluster == nil {
+		return findings
+	}
+	field := fmt.Sprintf("clusters[%v]", name)
+	if cluster.InsecureSkipTLSVerify && !p.AllowInsecureSkipTLSVerify {
+		findings = append(findings, &Finding{
+			Rule:     RuleInsecureSkipTLSVerify,
+			Severity: SeverityWarning,
+			Field:    field + ".insecure-skip-tls-verify",
+			Message:  "the server certificate is not verified",
+		})
+	}
+	if cluster.ProxyURL != "" {
+		if finding := p.checkProxyURL(field+".proxy-url", cluster.ProxyURL); finding != nil {
+			findings = append(findings, finding)
+		}
+	}
+	if cluster.CertificateAuthority != "" && !p.AllowFilePaths {
+		findings = append(findings, p.filePathFinding(field+".certificate-authority", cluster.CertificateAuthority))
+	}
+	return findings
+}
+
+func (p *KubeconfigPolicy) checkProxyURL(field string, proxyURL string) *Finding {
+	if !p.AllowProxyURL {
+		return &Finding{
+			Rule:     RuleProxyURL,
+			Severity: SeverityError,
+			Field:    field,
+			Message:  fmt.Sprintf("the requests would be sent through proxy %q", proxyURL),
+		}
+	}
+	u, err := url.Parse(proxyURL)
+	if err != nil || u.Host == "" {
+		return &Finding{
+			Rule:     RuleProxyURL,
+			Severity: SeverityError,
+			Field:    field,
+			Message:  fmt.Sprintf("proxy %q is not a valid URL", proxyURL),
+		}
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+		return nil
+	}
+	return &Finding{
+		Rule:     RuleProxyURL,
+		Severity: SeverityError,
+		Field:    field,
+		Message:  fmt.Sprintf("proxy scheme %q is not supported", u.Scheme),
+	}
+}
+
+func (p *KubeconfigPolicy) checkAuthInfo(name string, authInfo *clientcmdapi.AuthInfo) Findings {
+	findings := make(Findings, 0)
+	if authInfo == nil {
+		return findings
+	}
+	field := fmt.Sprintf("users[%v]", name)
+	if !p.AllowFilePaths {
+		for _, file := range []struct {
+			field string
+			path  string
+		}{
+			{"client-certificate", authInfo.ClientCertificate},
+			{"client-key", authInfo.ClientKey},
+			{"tokenFile", authInfo.TokenFile},
+		} {
+			if file.path != "" {
+				findings = append(findings, p.filePathFinding(field+"."+file.field, file.path))
+			}
+		}
+	}
+	if authInfo.Exec != nil {
+		findings = append(findings, p.checkExec(field+".exec", authInfo.Exec)...)
+	}
+	if authInfo.AuthProvider != nil && !contains(p.AllowedAuthProviders, authInfo.AuthProvider.Name) {
+		findings = append(findings, &Finding{
+			Rule:     RuleAuthProvider,
+			Severity: SeverityError,
+			Field:    field + ".auth-provider.name",
+			Message:  fmt.Sprintf("auth provider %q is not allowed", authInfo.AuthProvider.Name),
+		})
+	}
+	return findings
+}
+
+func (p *KubeconfigPolicy) checkExec(field string, exec *clientcmdapi.ExecConfig) Findings {
+	findings := make(Findings, 0)
+	if !p.execCommandAllowed(exec.Command, exec.Args) {
+		message := fmt.Sprintf("exec command %q is not allowed", exec.Command)
+		if len(exec.Args) > 0 {
+			message = fmt.Sprintf("exec command %q with args %q is not allowed", exec.Command, strings.Join(exec.Args, " "))
+		}
+		findings = append(findings, &Finding{
+			Rule:     RuleExecCommand,
+			Severity: SeverityError,
+			Field:    field + ".command",
+			Message:  message,
+		})
+	}
+	if !p.AllowFilePaths {
+		for idx, arg := range exec.Args {
+			value := arg
+			if tokens := strings.SplitN(arg, "=", 2); len(tokens) == 2 && strings.HasPrefix(arg, "-") {
+				value = tokens[1]
+			}
+			if isFilePath(value) {
+				findings = append(findings, p.filePathFinding(fmt.Sprintf("%v.args[%d]", field, idx), value))
+			}
+		}
+	}
+	for idx, env := range exec.Env {
+		envField := fmt.Sprintf("%v.env[%d]", field, idx)
+		if execEnvDenied(env.Name) {
+			findings = append(findings, &Finding{
+				Rule:     RuleExecEnv,
+				Severity: SeverityError,
+				Field:    envField + ".name",
+				Message:  fmt.Sprintf("environment variable %v changes the binaries or libraries loaded by the exec command", env.Name),
+			})
+		}
+		if !p.AllowFilePaths && isFilePath(env.Value) {
+			findings = append(findings, p.filePathFinding(envField+".value", env.Value))
+		}
+	}
+	return findings
+}
+
+func (p *KubeconfigPolicy) filePathFinding(field string, filePath string) *Finding {
+	return &Finding{
+		Rule:     RuleFilePath,
+		Severity: SeverityError,
+		Field:    field,
+		Message:  fmt.Sprintf("file %q would be read from the server, the data should be inlined instead", filePath),
+	}
+}
+
+func (p *KubeconfigPolicy) execCommandAllowed(command string, args []string) bool {
+	if command == "" {
+		return false
+	}
+	for _, allowed := range p.AllowedExecCommands {
+		fields := strings.Fields(allowed)
+		if len(fields) == 0 {
+			continue
+		}
+		allowedCommand, leadingArgs := fields[0], fields[1:]
+		if !hasLeadingArgs(args, leadingArgs) {
+			continue
+		}
+		if strings.Contains(allowedCommand, "/") {
+			if path.Clean(command) == path.Clean(allowedCommand) {
+				return true
+			}
+			continue
+		}
+		if command == allowedCommand {
+			return true
+		}
+	}
+	return false
+}
+
+// hasLeadingArgs returns true if the args start with the leading args
+func hasLeadingArgs(args []string, leadingArgs []string) bool {
+	if len(args) < len(leadingArgs) {
+		return false
+	}
+	for idx, arg := range leadingArgs {
+		if args[idx] != arg {
+			return false
+		}
+	}
+	return true
+}
+
+// isFilePath returns true if the value looks like a path on the filesystem
+func isFilePath(value string) bool {
+	for _, prefix := range []string{"/", "~/", "./", "../"} {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func execEnvDenied(name string) bool {
+	if deniedExecEnvs[name] {
+		return true
+	}
+	for _, prefix := range deniedExecEnvPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
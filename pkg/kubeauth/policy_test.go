@@ -0,0 +1,273 @@
+package kubeauth
+
+import (
+	"strings"
+	"testing"
+
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func testPolicy() *KubeconfigPolicy {
+	return &KubeconfigPolicy{
+		AllowedExecCommands: []string{
+			"aws eks get-token",
+			"kubelogin get-token",
+			"/usr/local/bin/aws-iam-authenticator token",
+		},
+		AllowedAuthProviders: []string{"gcp"},
+	}
+}
+
+func execUser(command string, args ...string) *clientcmdapi.AuthInfo {
+	return &clientcmdapi.AuthInfo{Exec: &clientcmdapi.ExecConfig{Command: command, Args: args}}
+}
+
+func TestCheckConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   *KubeconfigPolicy
+		cluster  *clientcmdapi.Cluster
+		user     *clientcmdapi.AuthInfo
+		expected []string
+	}{
+		{
+			name:     "allowed command with its leading args",
+			user:     execUser("aws", "eks", "get-token", "--cluster-name", "c1"),
+			expected: []string{},
+		},
+		{
+			name:     "allowed kubelogin",
+			user:     execUser("kubelogin", "get-token", "--server-id", "id"),
+			expected: []string{},
+		},
+		{
+			name:     "allowed path",
+			user:     execUser("/usr/local/bin/aws-iam-authenticator", "token", "-i", "c1"),
+			expected: []string{},
+		},
+		{
+			name:     "allowed command without args",
+			user:     execUser("aws"),
+			expected: []string{"ExecCommand users[u].exec.command"},
+		},
+		{
+			name:     "allowed command with other args",
+			user:     execUser("aws", "s3", "cp", "s3://bucket/key", "-"),
+			expected: []string{"ExecCommand users[u].exec.command"},
+		},
+		{
+			name:     "allowed command with a partial leading args",
+			user:     execUser("aws", "eks"),
+			expected: []string{"ExecCommand users[u].exec.command"},
+		},
+		{
+			name:     "other kubelogin subcommand",
+			user:     execUser("kubelogin", "convert-kubeconfig"),
+			expected: []string{"ExecCommand users[u].exec.command"},
+		},
+		{
+			name:     "allowed command from another path",
+			user:     execUser("/tmp/aws", "eks", "get-token"),
+			expected: []string{"ExecCommand users[u].exec.command"},
+		},
+		{
+			name:     "allowed path from the PATH",
+			user:     execUser("aws-iam-authenticator", "token"),
+			expected: []string{"ExecCommand users[u].exec.command"},
+		},
+		{
+			name:     "generic launcher",
+			user:     execUser("sh", "-c", "aws eks get-token"),
+			expected: []string{"ExecCommand users[u].exec.command"},
+		},
+		{
+			name: "denied env",
+			user: &clientcmdapi.AuthInfo{Exec: &clientcmdapi.ExecConfig{
+				Command: "aws",
+				Args:    []string{"eks", "get-token"},
+				Env:     []clientcmdapi.ExecEnvVar{{Name: "AWS_REGION", Value: "us-east-1"}, {Name: "LD_PRELOAD", Value: "evil.so"}},
+			}},
+			expected: []string{"ExecEnv users[u].exec.env[1].name"},
+		},
+		{
+			name: "file paths",
+			cluster: &clientcmdapi.Cluster{
+				Server:               "https://cluster.example.com",
+				CertificateAuthority: "/etc/kubernetes/ca.crt",
+			},
+			user: &clientcmdapi.AuthInfo{
+				ClientCertificate: "/etc/kubernetes/admin.crt",
+				ClientKey:         "./admin.key",
+				TokenFile:         "~/token",
+				Exec: &clientcmdapi.ExecConfig{
+					Command: "kubelogin",
+					Args:    []string{"get-token", "--token-cache-dir=/var/run/secrets"},
+					Env:     []clientcmdapi.ExecEnvVar{{Name: "AZURE_CONFIG_DIR", Value: "../azure"}},
+				},
+			},
+			expected: []string{
+				"FilePath clusters[c].certificate-authority",
+				"FilePath users[u].client-certificate",
+				"FilePath users[u].client-key",
+				"FilePath users[u].tokenFile",
+				"FilePath users[u].exec.args[1]",
+				"FilePath users[u].exec.env[0].value",
+			},
+		},
+		{
+			name: "allowed file paths",
+			policy: func() *KubeconfigPolicy {
+				p := testPolicy()
+				p.AllowFilePaths = true
+				return p
+			}(),
+			cluster:  &clientcmdapi.Cluster{Server: "https://cluster.example.com", CertificateAuthority: "/etc/kubernetes/ca.crt"},
+			user:     &clientcmdapi.AuthInfo{ClientCertificate: "/etc/kubernetes/admin.crt"},
+			expected: []string{},
+		},
+		{
+			name:     "insecure TLS",
+			cluster:  &clientcmdapi.Cluster{Server: "https://cluster.example.com", InsecureSkipTLSVerify: true},
+			expected: []string{"InsecureSkipTLSVerify clusters[c].insecure-skip-tls-verify"},
+		},
+		{
+			name: "allowed insecure TLS",
+			policy: func() *KubeconfigPolicy {
+				p := testPolicy()
+				p.AllowInsecureSkipTLSVerify = true
+				return p
+			}(),
+			cluster:  &clientcmdapi.Cluster{Server: "https://cluster.example.com", InsecureSkipTLSVerify: true},
+			expected: []string{},
+		},
+		{
+			name:     "proxy",
+			cluster:  &clientcmdapi.Cluster{Server: "https://cluster.example.com", ProxyURL: "http://proxy.example.com:3128"},
+			expected: []string{"ProxyURL clusters[c].proxy-url"},
+		},
+		{
+			name: "allowed proxy",
+			policy: func() *KubeconfigPolicy {
+				p := testPolicy()
+				p.AllowProxyURL = true
+				return p
+			}(),
+			cluster:  &clientcmdapi.Cluster{Server: "https://cluster.example.com", ProxyURL: "socks5://proxy.example.com:1080"},
+			expected: []string{},
+		},
+		{
+			name: "allowed proxy with an unsupported scheme",
+			policy: func() *KubeconfigPolicy {
+				p := testPolicy()
+				p.AllowProxyURL = true
+				return p
+			}(),
+			cluster:  &clientcmdapi.Cluster{Server: "https://cluster.example.com", ProxyURL: "file:///etc/passwd"},
+			expected: []string{"ProxyURL clusters[c].proxy-url"},
+		},
+		{
+			name:     "allowed auth provider",
+			user:     &clientcmdapi.AuthInfo{AuthProvider: &clientcmdapi.AuthProviderConfig{Name: "gcp"}},
+			expected: []string{},
+		},
+		{
+			name:     "auth provider",
+			user:     &clientcmdapi.AuthInfo{AuthProvider: &clientcmdapi.AuthProviderConfig{Name: "azure"}},
+			expected: []string{"AuthProvider users[u].auth-provider.name"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := clientcmdapi.NewConfig()
+			if test.cluster != nil {
+				config.Clusters["c"] = test.cluster
+			}
+			if test.user != nil {
+				config.AuthInfos["u"] = test.user
+			}
+			policy := test.policy
+			if policy == nil {
+				policy = testPolicy()
+			}
+			findings := CheckConfig(config, policy)
+			actual := make([]string, 0, len(findings))
+			for _, finding := range findings {
+				actual = append(actual, string(finding.Rule)+" "+finding.Field)
+			}
+			if strings.Join(actual, "\n") != strings.Join(test.expected, "\n") {
+				t.Errorf("expected findings %q, got %v", test.expected, findings)
+			}
+		})
+	}
+}
+
+func TestCheckKubeconfigSeverity(t *testing.T) {
+	findings, err := CheckKubeconfig(`apiVersion: v1
+kind: Config
+clusters:
+- name: c
+  cluster:
+    server: https://cluster.example.com
+    insecure-skip-tls-verify: true
+users:
+- name: u
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: aws
+      args: ["sts", "get-caller-identity"]
+`, testPolicy())
+	if err != nil {
+		t.Fatalf("CheckKubeconfig failed: %v", err)
+	}
+	if len(findings.Warnings()) != 1 || findings.Warnings()[0].Rule != RuleInsecureSkipTLSVerify {
+		t.Errorf("expected the insecure TLS warning, got %v", findings.Warnings())
+	}
+	if len(findings.Errors()) != 1 || findings.Errors()[0].Rule != RuleExecCommand {
+		t.Errorf("expected the exec command error, got %v", findings.Errors())
+	}
+	if err := findings.Err(); err == nil || !strings.Contains(err.Error(), "sts get-caller-identity") {
+		t.Errorf("expected the error to name the args, got %v", err)
+	}
+
+	if _, err := CheckKubeconfig("{", testPolicy()); err == nil {
+		t.Errorf("expected an invalid kubeconfig to fail")
+	}
+}
+
+func TestDefaultKubeconfigPolicy(t *testing.T) {
+	calls := withRegistry(t)
+	register(t, &testPlugin{name: "a", calls: calls}, &Descriptor{
+		CredentialType:      api.CloudCredentialInfo_AWS,
+		Matcher:             Matcher{ExecCommands: []string{"aws"}},
+		Capabilities:        Capabilities{KubeconfigRefresh: true},
+		AllowedExecCommands: []string{"aws eks get-token", "sh"},
+	})
+	register(t, &testPlugin{name: "b", calls: calls}, &Descriptor{
+		CredentialType:      api.CloudCredentialInfo_Google,
+		Matcher:             Matcher{AuthProviders: []string{"gcp"}},
+		Capabilities:        Capabilities{KubeconfigRefresh: true},
+		AllowedExecCommands: []string{"aws eks get-token"},
+	})
+	// The plugins which do not refresh the kubeconfigs allow nothing
+	register(t, &testPlugin{name: "c", calls: calls}, &Descriptor{
+		CredentialType:      api.CloudCredentialInfo_Azure,
+		Matcher:             Matcher{ExecCommands: []string{"kubelogin"}, AuthProviders: []string{"azure"}},
+		Capabilities:        Capabilities{Enumeration: true},
+		AllowedExecCommands: []string{"kubelogin get-token"},
+	})
+
+	policy := DefaultKubeconfigPolicy()
+	// The matched commands without leading args and the generic
+	// launchers are not allowed
+	if strings.Join(policy.AllowedExecCommands, ",") != "aws eks get-token" {
+		t.Errorf("unexpected exec commands %q", policy.AllowedExecCommands)
+	}
+	if strings.Join(policy.AllowedAuthProviders, ",") != "gcp" {
+		t.Errorf("unexpected auth providers %q", policy.AllowedAuthProviders)
+	}
+	if policy.AllowInsecureSkipTLSVerify || policy.AllowFilePaths || policy.AllowProxyURL {
+		t.Errorf("expected the default policy to be strict, got %+v", policy)
+	}
+}
@@ -0,0 +1,447 @@
+package rancher
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+	"github.com/portworx/px-backup-api/pkg/kubeauth"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	pluginName = "rancher"
+	// clusterProxyPath is the path under which Rancher proxies the
+	// requests to the downstream clusters.
+	// Sample Rancher proxied server url: https://rancher.example.com/k8s/clusters/c-m-4sdbk2n9
+	clusterProxyPath   = "/k8s/clusters/"
+	apiPath            = "/v3"
+	activeClusterState = "active"
+	// httpTimeout bounds the requests made to the Rancher server
+	httpTimeout = 30 * time.Second
+)
+
+type rancher struct {
+}
+
+// cluster is the subset of the Rancher v3 cluster resource
+// used by this plugin
+type cluster struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	State   string `json:"state"`
+	Version *struct {
+		GitVersion string `json:"gitVersion"`
+	} `json:"version"`
+}
+
+type clusterCollection struct {
+	Data       []cluster `json:"data"`
+	Pagination *struct {
+		Next string `json:"next"`
+	} `json:"pagination"`
+}
+
+type setting struct {
+	Value string `json:"value"`
+}
+
+// Init initializes the rancher auth plugin
+func (r *rancher) Init() error {
+	return nil
+}
+
+func (r *rancher) UpdateClient(
+	ctx context.Context,
+	conn *grpc.ClientConn,
+	cloudCredentialName string,
+	cloudCredentialUID string,
+	orgID string,
+	restConfig *rest.Config,
+	clientConfig *clientcmdapi.Config,
+) (bool, string, error) {
+	if isRancherProvider(restConfig) {
+		if cloudCredentialName == "" {
+			// The kubeconfig carries its own static token
+			return false, "", nil
+		}
+		cloudCredentialClient := api.NewCloudCredentialClient(conn)
+		resp, err := cloudCredentialClient.Inspect(
+			ctx,
+			&api.CloudCredentialInspectRequest{
+				Name:           cloudCredentialName,
+				Uid:            cloudCredentialUID,
+				OrgId:          orgID,
+				IncludeSecrets: true,
+			},
+		)
+		if err != nil {
+			return false, "", fmt.Errorf("failed to get credentials: %v", err)
+		}
+		return r.updateClient(restConfig, clientConfig, resp.GetCloudCredential())
+	}
+	return false, "", nil
+}
+
+func (r *rancher) UpdateClientByCredObject(
+	_ context.Context,
+	cloudCredential *api.CloudCredentialObject,
+	restConfig *rest.Config,
+	clientConfig *clientcmdapi.Config,
+) (bool, string, error) {
+	if isRancherProvider(restConfig) {
+		return r.updateClient(restConfig, clientConfig, cloudCredential)
+	}
+	return false, "", nil
+}
+
+// updateClient injects the Rancher token from the cloud credential in the
+// rest config and in the kubeconfig. It returns the updated kubeconfig.
+// The clients are left as is if no Rancher cloud credential is provided, as
+// the kubeconfigs of Rancher clusters can carry their own static token
+func (r *rancher) updateClient(
+	restConfig *rest.Config,
+	clientConfig *clientcmdapi.Config,
+	cloudCredential *api.CloudCredentialObject,
+) (bool, string, error) {
+	if cloudCredential.GetCloudCredentialInfo().GetType() != api.CloudCredentialInfo_Rancher {
+		return false, "", nil
+	}
+	rancherConfig := cloudCredential.GetCloudCredentialInfo().GetRancherConfig()
+	endpoint, err := validateConfig(rancherConfig)
+	if err != nil {
+		return false, "", err
+	}
+	if !strings.HasPrefix(restConfig.Host, endpoint+clusterProxyPath) {
+		// Do not send the token to a server other than the one it was issued by
+		return false, "", fmt.Errorf("cluster server %v is not proxied by Rancher endpoint %v", restConfig.Host, endpoint)
+	}
+
+	restConfig.BearerToken = rancherConfig.GetToken()
+	restConfig.BearerTokenFile = ""
+	restConfig.Username = ""
+	restConfig.Password = ""
+	restConfig.AuthProvider = nil
+	restConfig.ExecProvider = nil
+
+	if clientConfig == nil {
+		return true, "", nil
+	}
+	for _, authInfo := range clientConfig.AuthInfos {
+		authInfo.Token = rancherConfig.GetToken()
+		authInfo.TokenFile = ""
+		authInfo.Username = ""
+		authInfo.Password = ""
+		authInfo.AuthProvider = nil
+		authInfo.Exec = nil
+	}
+	kubeconfig, err := clientcmd.Write(*clientConfig)
+	if err != nil {
+		return false, "", err
+	}
+	return true, string(kubeconfig), nil
+}
+
+func (r *rancher) GetClient(
+	ctx context.Context,
+	cloudCredential *api.CloudCredentialObject,
+	clusterName string,
+	region string,
+) (*kubeauth.PluginClient, error) {
+	rancherConfig := cloudCredential.GetCloudCredentialInfo().GetRancherConfig()
+	if rancherConfig == nil {
+		return nil, fmt.Errorf("cloud credentials are not for rancher")
+	}
+	return GetRestConfigForCluster(ctx, clusterName, rancherConfig)
+}
+
+func (r *rancher) GetAllClients(
+	ctx context.Context,
+	cloudCredential *api.CloudCredentialObject,
+	maxResults int64,
+	config interface{},
+) (map[string]*kubeauth.PluginClient, *string, error) {
+	rancherConfig := cloudCredential.GetCloudCredentialInfo().GetRancherConfig()
+	if rancherConfig == nil {
+		return nil, nil, fmt.Errorf("cloud credentials are not for rancher")
+	}
+	return GetRestConfigForAllClusters(ctx, rancherConfig, maxResults, config)
+}
+
+// GetRestConfigForCluster returns the client for the downstream cluster
+// with the given name
+func GetRestConfigForCluster(ctx context.Context, clusterName string, rancherConfig *api.RancherConfig) (*kubeauth.PluginClient, error) {
+	endpoint, err := validateConfig(rancherConfig)
+	if err != nil {
+		return nil, err
+	}
+	httpClient, err := newHTTPClient(rancherConfig.GetCaBundle())
+	if err != nil {
+		return nil, err
+	}
+	query := url.Values{}
+	query.Set("name", clusterName)
+	clusters := &clusterCollection{}
+	if err := get(ctx, httpClient, endpoint+apiPath+"/clusters?"+query.Encode(), rancherConfig.GetToken(), clusters); err != nil {
+		return nil, err
+	}
+	if len(clusters.Data) == 0 {
+		return nil, fmt.Errorf("Rancher cluster %v not found", clusterName)
+	}
+	if len(clusters.Data) > 1 {
+		ids := make([]string, 0, len(clusters.Data))
+		for _, cluster := range clusters.Data {
+			ids = append(ids, cluster.ID)
+		}
+		return nil, fmt.Errorf("Rancher cluster name %v is ambiguous, it matches the clusters %v", clusterName, strings.Join(ids, ", "))
+	}
+	caData, err := getCACerts(ctx, httpClient, endpoint, rancherConfig)
+	if err != nil {
+		return nil, err
+	}
+	return getPluginClient(endpoint, rancherConfig.GetToken(), caData, &clusters.Data[0])
+}
+
+// GetRestConfigForAllClusters returns the clients for all the downstream
+// clusters which the Rancher token has access to. The clients are keyed by
+// the cluster ID as the cluster names are display names which are not
+// unique
+func GetRestConfigForAllClusters(
+	ctx context.Context,
+	rancherConfig *api.RancherConfig,
+	maxResults int64,
+	config interface{},
+) (map[string]*kubeauth.PluginClient, *string, error) {
+	funct := "GetRestConfigForAllClusters"
+	rancherCfg, ok := config.(*api.ManagedClusterEnumerateRequest_RancherConfig)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid config %T provided for Rancher cluster scan", config)
+	}
+	endpoint, err := validateConfig(rancherConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	httpClient, err := newHTTPClient(rancherConfig.GetCaBundle())
+	if err != nil {
+		return nil, nil, err
+	}
+	query := url.Values{}
+	if maxResults != 0 {
+		query.Set("limit", fmt.Sprintf("%d", maxResults))
+	}
+	if rancherCfg.GetNextToken() != "" {
+		query.Set("marker", rancherCfg.GetNextToken())
+	}
+	clusters := &clusterCollection{}
+	if err := get(ctx, httpClient, endpoint+apiPath+"/clusters?"+query.Encode(), rancherConfig.GetToken(), clusters); err != nil {
+		return nil, nil, err
+	}
+	logrus.Tracef("%s: listed %v clusters", funct, len(clusters.Data))
+	caData, err := getCACerts(ctx, httpClient, endpoint, rancherConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	restConfigs := make(map[string]*kubeauth.PluginClient)
+	for idx := range clusters.Data {
+		cluster := &clusters.Data[idx]
+		// On error continue to next cluster as we don't want to stop the
+		// scan for one cluster error.
+		var version string
+		if cluster.Version != nil {
+			version = cluster.Version.GitVersion
+		}
+		if cluster.State != activeClusterState {
+			kubeauth.ReportSkippedCluster(ctx, cluster.ID, &kubeauth.SkippedCluster{
+				Uid:     cluster.ID,
+				Version: version,
+				Reason:  fmt.Sprintf("cluster is in %v state", cluster.State),
+			})
+			continue
+		}
+		pluginClient, err := getPluginClient(endpoint, rancherConfig.GetToken(), caData, cluster)
+		if err != nil {
+			kubeauth.ReportSkippedCluster(ctx, cluster.ID, &kubeauth.SkippedCluster{
+				Uid:     cluster.ID,
+				Version: version,
+				Reason:  err.Error(),
+			})
+			continue
+		}
+		restConfigs[cluster.ID] = pluginClient
+	}
+
+	var nextToken *string
+	if clusters.Pagination != nil && clusters.Pagination.Next != "" {
+		next, err := url.Parse(clusters.Pagination.Next)
+		if err != nil {
+			return nil, nil, err
+		}
+		if marker := next.Query().Get("marker"); marker != "" {
+			nextToken = &marker
+		}
+	}
+	return restConfigs, nextToken, nil
+}
+
+func getPluginClient(endpoint string, token string, caData []byte, cluster *cluster) (*kubeauth.PluginClient, error) {
+	config := buildConfig(endpoint+clusterProxyPath+cluster.ID, cluster.Name, caData, token)
+	kubeconfig, err := clientcmd.Write(*config)
+	if err != nil {
+		return nil, err
+	}
+	restConfig, err := clientcmd.NewDefaultClientConfig(*config, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	var version string
+	if cluster.Version != nil {
+		version = cluster.Version.GitVersion
+	}
+	return &kubeauth.PluginClient{
+		Kubeconfig: string(kubeconfig),
+		Rest:       restConfig,
+		Uid:        cluster.ID,
+		Version:    version,
+	}, nil
+}
+
+// getCACerts returns the PEM encoded CA certificates of the Rancher server.
+// The ca_bundle of the cloud credential is returned if Rancher does not
+// report any, and it is empty when Rancher uses certificates signed by a
+// public CA
+func getCACerts(ctx context.Context, httpClient *http.Client, endpoint string, rancherConfig *api.RancherConfig) ([]byte, error) {
+	caCerts := &setting{}
+	if err := get(ctx, httpClient, endpoint+apiPath+"/settings/cacerts", rancherConfig.GetToken(), caCerts); err != nil {
+		return nil, fmt.Errorf("failed to get Rancher CA certificates: %v", err)
+	}
+	if strings.TrimSpace(caCerts.Value) == "" {
+		caCerts.Value = rancherConfig.GetCaBundle()
+	}
+	if strings.TrimSpace(caCerts.Value) == "" {
+		return nil, nil
+	}
+	return []byte(caCerts.Value), nil
+}
+
+func get(ctx context.Context, httpClient *http.Client, reqURL string, token string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%v: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return json.Unmarshal(body, out)
+}
+
+// newHTTPClient returns the client for the requests made to the Rancher
+// server, trusting the CA bundle in addition to the system CAs
+func newHTTPClient(caBundle string) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if caBundle != "" {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM([]byte(caBundle)) {
+			return nil, fmt.Errorf("failed to parse the ca_bundle of the Rancher CloudCredential")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs}
+	}
+	return &http.Client{
+		Transport: transport,
+		Timeout:   httpTimeout,
+	}, nil
+}
+
+func validateConfig(rancherConfig *api.RancherConfig) (string, error) {
+	endpoint := normalizeEndpoint(rancherConfig.GetEndpoint())
+	if endpoint == "" {
+		return "", fmt.Errorf("endpoint not provided in the Rancher CloudCredential")
+	}
+	if rancherConfig.GetToken() == "" {
+		return "", fmt.Errorf("token not provided in the Rancher CloudCredential")
+	}
+	return endpoint, nil
+}
+
+// normalizeEndpoint strips the trailing slash and the API version
+// from the Rancher endpoint
+func normalizeEndpoint(endpoint string) string {
+	endpoint = strings.TrimSuffix(strings.TrimSpace(endpoint), "/")
+	return strings.TrimSuffix(endpoint, apiPath)
+}
+
+func isRancherProvider(client *rest.Config) bool {
+	u, err := url.Parse(client.Host)
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(u.Path, clusterProxyPath)
+}
+
+// buildConfig returns the kubeconfig for the Rancher proxied cluster.
+// It is the same kubeconfig that the Rancher UI generates with
+// the provided token
+func buildConfig(
+	server string,
+	clusterName string,
+	caData []byte,
+	token string,
+) *clientcmdapi.Config {
+	config := clientcmdapi.NewConfig()
+	config.Clusters[clusterName] = &clientcmdapi.Cluster{
+		Server:                   server,
+		CertificateAuthorityData: caData,
+	}
+	config.AuthInfos[clusterName] = &clientcmdapi.AuthInfo{
+		Token: token,
+	}
+	config.Contexts[clusterName] = &clientcmdapi.Context{
+		Cluster:  clusterName,
+		AuthInfo: clusterName,
+	}
+	config.CurrentContext = clusterName
+	return config
+}
+
+func init() {
+	descriptor := &kubeauth.Descriptor{
+		CredentialType: api.CloudCredentialInfo_Rancher,
+		Matcher: kubeauth.Matcher{
+			ServerPathPrefixes: []string{clusterProxyPath},
+		},
+		Capabilities: kubeauth.Capabilities{
+			KubeconfigRefresh: true,
+			SingleLookup:      true,
+			Enumeration:       true,
+		},
+	}
+	if err := kubeauth.RegisterPlugin(pluginName, &rancher{}, descriptor); err != nil {
+		logrus.Panicf("Error registering rancher auth plugin: %v", err)
+	}
+}
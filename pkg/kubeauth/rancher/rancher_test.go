@@ -0,0 +1,167 @@
+package rancher_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+	"github.com/portworx/px-backup-api/pkg/kubeauth"
+	"github.com/portworx/px-backup-api/pkg/kubeauth/fake"
+	_ "github.com/portworx/px-backup-api/pkg/kubeauth/rancher"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const testToken = "token-fake:secret"
+
+func newFake(t *testing.T, clusters ...*fake.Cluster) *fake.Rancher {
+	server := fake.NewRancher(testToken, clusters...)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newCloudCredential(server *fake.Rancher, token string) *api.CloudCredentialObject {
+	return fake.NewCloudCredential(&api.RancherConfig{
+		Endpoint: server.URL() + "/v3/",
+		Token:    token,
+		CaBundle: string(server.CAData()),
+	})
+}
+
+func TestGetAllClients(t *testing.T) {
+	server := newFake(t,
+		&fake.Cluster{Name: "prod", ID: "c-1", Version: "v1.29.1+rke2r1"},
+		&fake.Cluster{Name: "prod", ID: "c-2", Version: "v1.28.5+rke2r1", Status: "provisioning"},
+		&fake.Cluster{Name: "dev", ID: "c-3", Version: "v1.28.5+k3s1"},
+	)
+	cloudCred := newCloudCredential(server, testToken)
+	config := &api.ManagedClusterEnumerateRequest_RancherConfig{}
+
+	// The skipped clusters count towards the page size
+	pages := fake.AllClients(t, cloudCred, 2, config)
+	if len(pages) != 2 {
+		t.Errorf("expected 2 pages, got %v", len(pages))
+	}
+	clients, skipped := fake.Merge(pages)
+	if len(clients) != 2 || clients["c-1"] == nil || clients["c-3"] == nil {
+		t.Fatalf("expected the clients of c-1 and c-3, got %v", clients)
+	}
+	if skipped["c-2"] == nil || !strings.Contains(skipped["c-2"].Reason, "provisioning") {
+		t.Errorf("expected c-2 to be skipped, got %v", skipped)
+	}
+	if version := fake.ServerVersion(t, clients["c-1"].Rest); version != "v1.29.1+rke2r1" {
+		t.Errorf("unexpected server version %v", version)
+	}
+
+	// The ca_bundle is used when Rancher does not report its CA
+	server.SetReportCACerts(false)
+	clients, _, _, err := kubeauth.GetAllClientsWithSkipped(
+		context.Background(), cloudCred, 0, &api.ManagedClusterEnumerateRequest_RancherConfig{})
+	if err != nil {
+		t.Fatalf("GetAllClientsWithSkipped failed: %v", err)
+	}
+	if version := fake.ServerVersion(t, clients["c-3"].Rest); version != "v1.28.5+k3s1" {
+		t.Errorf("unexpected server version %v", version)
+	}
+
+	if _, _, _, err := kubeauth.GetAllClientsWithSkipped(
+		context.Background(), newCloudCredential(server, "wrong"), 0, &api.ManagedClusterEnumerateRequest_RancherConfig{}); err == nil {
+		t.Errorf("expected an invalid token to fail")
+	}
+}
+
+func TestGetClient(t *testing.T) {
+	server := newFake(t,
+		&fake.Cluster{Name: "prod", ID: "c-1", Version: "v1.29.1+rke2r1"},
+		&fake.Cluster{Name: "prod", ID: "c-2", Version: "v1.28.5+rke2r1"},
+		&fake.Cluster{Name: "dev", ID: "c-3", Version: "v1.28.5+k3s1"},
+	)
+	cloudCred := newCloudCredential(server, testToken)
+
+	_, err := kubeauth.GetClientWithContext(context.Background(), cloudCred, "prod", "")
+	if err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("expected an ambiguity error, got %v", err)
+	}
+	client, err := kubeauth.GetClientWithContext(context.Background(), cloudCred, "dev", "")
+	if err != nil {
+		t.Fatalf("GetClientWithContext failed: %v", err)
+	}
+	if client.Uid != "c-3" || client.Version != "v1.28.5+k3s1" {
+		t.Errorf("unexpected client %+v", client)
+	}
+	if version := fake.ServerVersion(t, client.Rest); version != "v1.28.5+k3s1" {
+		t.Errorf("unexpected server version %v", version)
+	}
+}
+
+func TestUpdateClientByCredObject(t *testing.T) {
+	server := newFake(t, &fake.Cluster{Name: "prod", ID: "c-1", Version: "v1.29.1+rke2r1"})
+	cloudCred := newCloudCredential(server, testToken)
+
+	for _, test := range []struct {
+		server string
+		fails  bool
+	}{
+		{server: server.URL() + "/k8s/clusters/c-1"},
+		{server: "https://other.example.com/k8s/clusters/c-1", fails: true},
+	} {
+		clientConfig, err := clientcmd.Load([]byte(`apiVersion: v1
+kind: Config
+current-context: prod
+clusters:
+- name: prod
+  cluster:
+    server: ` + test.server + `
+contexts:
+- name: prod
+  context:
+    cluster: prod
+    user: prod
+users:
+- name: prod
+  user:
+    token: expired
+`))
+		if err != nil {
+			t.Fatalf("failed to load the kubeconfig: %v", err)
+		}
+		restConfig, err := clientcmd.NewDefaultClientConfig(*clientConfig, &clientcmd.ConfigOverrides{}).ClientConfig()
+		if err != nil {
+			t.Fatalf("failed to build the rest config: %v", err)
+		}
+		kubeconfig, err := kubeauth.UpdateClientByCredObjectWithContext(context.Background(), cloudCred, restConfig, clientConfig)
+		if test.fails {
+			if err == nil {
+				t.Errorf("expected the update of %v to fail", test.server)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("UpdateClientByCredObjectWithContext failed: %v", err)
+		}
+		if restConfig.BearerToken != testToken || !strings.Contains(kubeconfig, testToken) {
+			t.Errorf("expected the token to be replaced, got %q and:\n%v", restConfig.BearerToken, kubeconfig)
+		}
+	}
+}
+
+func TestKubeconfigEscapesClusterName(t *testing.T) {
+	name := "{prod: x}\nusers:\n- name: evil #"
+	server := newFake(t, &fake.Cluster{Name: name, ID: "c-1", Version: "v1.29.1+rke2r1"})
+	cloudCred := newCloudCredential(server, testToken)
+
+	client, err := kubeauth.GetClientWithContext(context.Background(), cloudCred, name, "")
+	if err != nil {
+		t.Fatalf("GetClientWithContext failed: %v", err)
+	}
+	config, err := clientcmd.Load([]byte(client.Kubeconfig))
+	if err != nil {
+		t.Fatalf("failed to load the kubeconfig: %v\n%v", err, client.Kubeconfig)
+	}
+	if len(config.Clusters) != 1 || len(config.AuthInfos) != 1 || config.Clusters[name] == nil || config.AuthInfos[name] == nil {
+		t.Fatalf("unexpected kubeconfig:\n%v", client.Kubeconfig)
+	}
+	if config.Clusters[name].Server != server.URL()+"/k8s/clusters/c-1" || config.AuthInfos[name].Token != testToken {
+		t.Errorf("unexpected kubeconfig:\n%v", client.Kubeconfig)
+	}
+}
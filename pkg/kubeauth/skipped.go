@@ -0,0 +1,66 @@
+package kubeauth
+
+import (
+	"context"
+	"sync"
+
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// SkippedCluster is a cluster which was found while enumerating the clusters
+// of a cloud credential but could not be accessed. A region which could not
+// be scanned is also reported as a skipped cluster with an empty Uid
+type SkippedCluster struct {
+	// Uid uniquely identifies the managed cluster by the cloud provider
+	Uid string
+	// k8s version, if known
+	Version string
+	// Region of the managed cluster
+	Region string
+	// Reason the cluster was skipped
+	Reason string
+}
+
+type skippedClustersKey struct{}
+
+// skippedClusters collects the clusters skipped by a plugin
+type skippedClusters struct {
+	lock     sync.Mutex
+	clusters map[string]*SkippedCluster
+}
+
+// ReportSkippedCluster reports a cluster the plugin skipped while
+// enumerating the clusters, keyed the same way as the returned clients.
+// The skipped clusters are only returned by GetAllClientsWithSkipped, the
+// other callers only get them logged
+func ReportSkippedCluster(ctx context.Context, key string, cluster *SkippedCluster) {
+	logrus.Infof("skipping cluster %v: %v", key, cluster.Reason)
+	skipped, ok := ctx.Value(skippedClustersKey{}).(*skippedClusters)
+	if !ok {
+		return
+	}
+	skipped.lock.Lock()
+	defer skipped.lock.Unlock()
+	skipped.clusters[key] = cluster
+}
+
+// GetAllClientsWithSkipped is GetAllClientsWithContext which also returns
+// the clusters the plugin found but could not access, like the clusters
+// which are not active or which the cloud credential has no permission for
+func GetAllClientsWithSkipped(
+	ctx context.Context,
+	cloudCred *api.CloudCredentialObject,
+	maxResult int64,
+	config interface{},
+) (map[string]*PluginClient, map[string]*SkippedCluster, *string, error) {
+	skipped := &skippedClusters{clusters: make(map[string]*SkippedCluster)}
+	ctx = context.WithValue(ctx, skippedClustersKey{}, skipped)
+	clients, nextToken, err := GetAllClientsWithContext(ctx, cloudCred, maxResult, config)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	skipped.lock.Lock()
+	defer skipped.lock.Unlock()
+	return clients, skipped.clusters, nextToken, nil
+}
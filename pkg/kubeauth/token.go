@@ -0,0 +1,221 @@
+package kubeauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// DefaultTokenRefreshWindow is how long before its expiry a
+	// cached token is regenerated
+	DefaultTokenRefreshWindow = 2 * time.Minute
+	// DefaultTokenSourceIdleTTL is how long a token source is kept
+	// in the cache after it was last used
+	DefaultTokenSourceIdleTTL = time.Hour
+	// DefaultMaxTokenSources is the maximum number of token sources
+	// kept in the cache. The least recently used ones are evicted
+	// beyond it
+	DefaultMaxTokenSources = 1024
+)
+
+// TokenGenerator generates a new bearer token and returns it
+// along with its expiry time. A zero expiry time means the
+// token does not expire. The context is the one of the request
+// the token is generated for
+type TokenGenerator func(ctx context.Context) (string, time.Time, error)
+
+// OAuth2TokenGenerator returns a TokenGenerator for the access
+// tokens of the OAuth2 token source. The OAuth2 token sources do
+// not take a context, so the context is not passed through
+func OAuth2TokenGenerator(ts oauth2.TokenSource) TokenGenerator {
+	return func(context.Context) (string, time.Time, error) {
+		tok, err := ts.Token()
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		return tok.AccessToken, tok.Expiry, nil
+	}
+}
+
+// TokenFingerprint returns a digest of the values which a token is
+// generated from, like the secrets of a cloud credential. It is meant
+// as the fingerprint passed to GetCachedTokenSource
+func TokenFingerprint(values ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(values, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// CachedTokenSource returns a cached bearer token and regenerates
+// it before it expires
+type CachedTokenSource struct {
+	key           string
+	refreshWindow time.Duration
+	// lastUsed is the unix nano time the token source was last
+	// used at. It is used for evicting the idle token sources
+	lastUsed int64
+
+	lock        sync.Mutex
+	fingerprint string
+	generate    TokenGenerator
+	token       string
+	expiry      time.Time
+}
+
+var (
+	tokenSourcesLock   sync.Mutex
+	tokenSources       = make(map[string]*CachedTokenSource)
+	tokenSourceIdleTTL = DefaultTokenSourceIdleTTL
+	maxTokenSources    = DefaultMaxTokenSources
+)
+
+// GetCachedTokenSource returns the token source cached for the given key.
+// The key should identify both the cluster and the identity the token is
+// generated for, and stay the same when the identity renews its secrets,
+// like the role ARN of an AWS credential. The fingerprint should change
+// when the secrets the tokens are generated from change. A new token
+// source is created with the provided generator if none is cached for
+// the key. If the cached one has another fingerprint its generator is
+// replaced with the provided one and its token is dropped, so that the
+// clients already using it switch to the new secrets.
+//
+// The token sources not used for DefaultTokenSourceIdleTTL are evicted,
+// as are the least recently used ones beyond DefaultMaxTokenSources. An
+// evicted token source keeps working for the clients using it, it is
+// only not shared anymore
+func GetCachedTokenSource(key string, fingerprint string, generate TokenGenerator) *CachedTokenSource {
+	tokenSourcesLock.Lock()
+	now := time.Now()
+	ts, exists := tokenSources[key]
+	if !exists {
+		evictTokenSources(now)
+		ts = &CachedTokenSource{
+			key:           key,
+			refreshWindow: DefaultTokenRefreshWindow,
+			fingerprint:   fingerprint,
+			generate:      generate,
+		}
+		tokenSources[key] = ts
+	}
+	ts.touch(now)
+	// The token source lock is held while a token is generated, so it is
+	// not taken with the cache lock held
+	tokenSourcesLock.Unlock()
+
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
+	if ts.fingerprint != fingerprint {
+		ts.fingerprint = fingerprint
+		ts.generate = generate
+		ts.token = ""
+		ts.expiry = time.Time{}
+	}
+	return ts
+}
+
+// deleteCachedTokenSource removes the token source cached for the given
+// key. The callers rely on the eviction of the idle token sources, as the
+// keys are built by the plugins
+func deleteCachedTokenSource(key string) {
+	tokenSourcesLock.Lock()
+	defer tokenSourcesLock.Unlock()
+	delete(tokenSources, key)
+}
+
+// evictTokenSources removes the idle token sources and, if the cache is
+// still full, the least recently used one. It expects tokenSourcesLock
+// to be held
+func evictTokenSources(now time.Time) {
+	var lruKey string
+	var lruTime int64
+	for key, ts := range tokenSources {
+		lastUsed := atomic.LoadInt64(&ts.lastUsed)
+		if now.Sub(time.Unix(0, lastUsed)) > tokenSourceIdleTTL {
+			delete(tokenSources, key)
+			continue
+		}
+		if lruKey == "" || lastUsed < lruTime {
+			lruKey, lruTime = key, lastUsed
+		}
+	}
+	if len(tokenSources) >= maxTokenSources && lruKey != "" {
+		delete(tokenSources, lruKey)
+	}
+}
+
+func (c *CachedTokenSource) touch(now time.Time) {
+	atomic.StoreInt64(&c.lastUsed, now.UnixNano())
+}
+
+// Token returns the cached token if it is not about to
+// expire, else it generates a new one with the context
+func (c *CachedTokenSource) Token(ctx context.Context) (string, error) {
+	c.touch(time.Now())
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.token != "" && (c.expiry.IsZero() || time.Now().Add(c.refreshWindow).Before(c.expiry)) {
+		return c.token, nil
+	}
+	token, expiry, err := c.generate(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate token for %v: %v", c.key, err)
+	}
+	c.token = token
+	c.expiry = expiry
+	return c.token, nil
+}
+
+// Invalidate drops the cached token if it is the provided one so
+// that the next call to Token generates a new one
+func (c *CachedTokenSource) Invalidate(token string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.token == token {
+		c.token = ""
+		c.expiry = time.Time{}
+	}
+}
+
+// WrapTransport returns a function to be set as the rest.Config
+// WrapTransport which adds the bearer token from the token source
+// to every request. The BearerToken and BearerTokenFile of the
+// rest.Config should be left empty
+func (c *CachedTokenSource) WrapTransport() func(rt http.RoundTripper) http.RoundTripper {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &tokenRoundTripper{source: c, base: rt}
+	}
+}
+
+type tokenRoundTripper struct {
+	source *CachedTokenSource
+	base   http.RoundTripper
+}
+
+func (t *tokenRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.source.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	newReq := req.Clone(req.Context())
+	newReq.Header.Set("Authorization", "Bearer "+token)
+	resp, err := t.base.RoundTrip(newReq)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized {
+		// The token may have been revoked or the clocks may be skewed,
+		// generate a new one for the next request
+		t.source.Invalidate(token)
+	}
+	return resp, err
+}
+
+// WrappedRoundTripper returns the wrapped round tripper
+func (t *tokenRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return t.base
+}
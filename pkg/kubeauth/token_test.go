@@ -0,0 +1,167 @@
+package kubeauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// withTokenSources runs the test with an empty token source cache
+func withTokenSources(t *testing.T, idleTTL time.Duration, maxSources int) {
+	tokenSourcesLock.Lock()
+	savedSources, savedTTL, savedMax := tokenSources, tokenSourceIdleTTL, maxTokenSources
+	tokenSources, tokenSourceIdleTTL, maxTokenSources = make(map[string]*CachedTokenSource), idleTTL, maxSources
+	tokenSourcesLock.Unlock()
+	t.Cleanup(func() {
+		tokenSourcesLock.Lock()
+		defer tokenSourcesLock.Unlock()
+		tokenSources, tokenSourceIdleTTL, maxTokenSources = savedSources, savedTTL, savedMax
+	})
+}
+
+// counter returns a generator of numbered tokens which expire after the
+// given duration, and the number of tokens it generated
+func counter(prefix string, expiresIn time.Duration) (TokenGenerator, *int32) {
+	var generated int32
+	return func(context.Context) (string, time.Time, error) {
+		n := atomic.AddInt32(&generated, 1)
+		var expiry time.Time
+		if expiresIn != 0 {
+			expiry = time.Now().Add(expiresIn)
+		}
+		return fmt.Sprintf("%s-%d", prefix, n), expiry, nil
+	}, &generated
+}
+
+func cached(key string) bool {
+	tokenSourcesLock.Lock()
+	defer tokenSourcesLock.Unlock()
+	_, ok := tokenSources[key]
+	return ok
+}
+
+func TestCachedTokenSourceRefresh(t *testing.T) {
+	withTokenSources(t, DefaultTokenSourceIdleTTL, DefaultMaxTokenSources)
+	tests := []struct {
+		name      string
+		expiresIn time.Duration
+		generated int32
+	}{
+		{name: "never expires", expiresIn: 0, generated: 1},
+		{name: "expires after the refresh window", expiresIn: time.Hour, generated: 1},
+		{name: "expires within the refresh window", expiresIn: DefaultTokenRefreshWindow / 2, generated: 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			generate, generated := counter("token", test.expiresIn)
+			ts := GetCachedTokenSource(test.name, "fp", generate)
+			for i := 0; i < 3; i++ {
+				if _, err := ts.Token(context.Background()); err != nil {
+					t.Fatalf("Token failed: %v", err)
+				}
+			}
+			if *generated != test.generated {
+				t.Errorf("expected %v tokens to be generated, got %v", test.generated, *generated)
+			}
+		})
+	}
+}
+
+func TestCachedTokenSourceFingerprint(t *testing.T) {
+	withTokenSources(t, DefaultTokenSourceIdleTTL, DefaultMaxTokenSources)
+	oldGenerate, _ := counter("old", time.Hour)
+	ts := GetCachedTokenSource("key", "fp1", oldGenerate)
+	if token, _ := ts.Token(context.Background()); token != "old-1" {
+		t.Fatalf("unexpected token %v", token)
+	}
+
+	// The same fingerprint keeps the cached generator and token
+	unused, unusedGenerated := counter("unused", time.Hour)
+	if GetCachedTokenSource("key", "fp1", unused) != ts {
+		t.Fatalf("expected the cached token source to be returned")
+	}
+	if token, _ := ts.Token(context.Background()); token != "old-1" || *unusedGenerated != 0 {
+		t.Errorf("expected the cached token, got %v", token)
+	}
+
+	// Another fingerprint replaces the generator of the token source the
+	// clients already use and drops its token
+	newGenerate, _ := counter("new", time.Hour)
+	if GetCachedTokenSource("key", "fp2", newGenerate) != ts {
+		t.Fatalf("expected the cached token source to be returned")
+	}
+	if token, _ := ts.Token(context.Background()); token != "new-1" {
+		t.Errorf("expected a token from the new generator, got %v", token)
+	}
+
+	deleteCachedTokenSource("key")
+	if cached("key") {
+		t.Errorf("expected the token source to be deleted")
+	}
+}
+
+func TestCachedTokenSourceEviction(t *testing.T) {
+	withTokenSources(t, time.Hour, 2)
+	generate, _ := counter("token", 0)
+
+	idle := GetCachedTokenSource("idle", "fp", generate)
+	atomic.StoreInt64(&idle.lastUsed, time.Now().Add(-2*time.Hour).UnixNano())
+	GetCachedTokenSource("a", "fp", generate)
+	if cached("idle") || !cached("a") {
+		t.Fatalf("expected the idle token source to be evicted")
+	}
+	// An evicted token source keeps working
+	if _, err := idle.Token(context.Background()); err != nil {
+		t.Errorf("Token failed: %v", err)
+	}
+
+	b := GetCachedTokenSource("b", "fp", generate)
+	atomic.StoreInt64(&b.lastUsed, time.Now().Add(-time.Minute).UnixNano())
+	GetCachedTokenSource("c", "fp", generate)
+	if !cached("a") || cached("b") || !cached("c") {
+		t.Errorf("expected the least recently used token source to be evicted")
+	}
+}
+
+func TestWrapTransportInvalidatesRejectedTokens(t *testing.T) {
+	withTokenSources(t, DefaultTokenSourceIdleTTL, DefaultMaxTokenSources)
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get("Authorization"))
+		// The first token is revoked
+		if r.Header.Get("Authorization") == "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	generate, generated := counter("token", time.Hour)
+	ts := GetCachedTokenSource("key", "fp", generate)
+	client := &http.Client{Transport: ts.WrapTransport()(http.DefaultTransport)}
+	expected := []int{http.StatusUnauthorized, http.StatusOK, http.StatusOK}
+	for i, status := range expected {
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		// The token of the caller is replaced
+		req.Header.Set("Authorization", "Bearer caller")
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != status {
+			t.Errorf("request %v: expected %v, got %v", i, status, resp.StatusCode)
+		}
+	}
+	if *generated != 2 {
+		t.Errorf("expected a new token after the 401 only, got %v tokens", *generated)
+	}
+	if received[0] != "Bearer token-1" || received[1] != "Bearer token-2" || received[2] != "Bearer token-2" {
+		t.Errorf("unexpected tokens %v", received)
+	}
+}
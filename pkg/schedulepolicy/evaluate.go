@@ -0,0 +1,327 @@
+package schedulepolicy
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+)
+
+// Mode is the mode of the backup taken by a run
+type Mode string
+
+const (
+	// ModeFull is a run which takes a full backup
+	ModeFull Mode = "Full"
+	// ModeIncremental is a run which takes an incremental backup
+	ModeIncremental Mode = "Incremental"
+)
+
+// MaxRuns is the maximum number of runs returned by an evaluation
+const MaxRuns = 1000
+
+// monthsToSearch bounds the search for the next run of a monthly policy.
+// It covers the dates like 29 Feb which only occur in leap years
+const monthsToSearch = 12 * 8
+
+// Run is a trigger of a schedule policy
+type Run struct {
+	// Time of the trigger
+	Time time.Time
+	// PolicyType of the policy which triggers the run. It is the
+	// retention bucket the backup of the run is kept in
+	PolicyType PolicyType
+	// Retain is the retain count of the retention bucket
+	Retain int64
+	// Mode of the backup taken by the run
+	Mode Mode
+	// RetainedUntil is the time of the run of the same policy type which
+	// moves the backup of this run out of the retain count. It is zero if
+	// that run is beyond the evaluated runs, and for the policies for
+	// object lock
+	RetainedUntil time.Time
+}
+
+// State is the state of a backup schedule which the runs of its policy
+// are evaluated from. A nil state evaluates the policy as if the schedule
+// had just been created
+type State struct {
+	// LastRun is the time of the last run of each policy type. The
+	// interval policy triggers the interval after its last run, or
+	// immediately if it has not run yet or is overdue
+	LastRun map[PolicyType]time.Time
+	// RunsSinceFull is the number of runs of each policy type since and
+	// including its last full backup. The next run takes a full backup
+	// if it is 0
+	RunsSinceFull map[PolicyType]uint64
+	// Anchor is a time in a week the bi-weekly policy triggers in. The
+	// week of the evaluation start is used if not set, so the bi-weekly
+	// runs of an existing schedule should be evaluated with its anchor
+	Anchor time.Time
+}
+
+// StateFromProto converts the API representation of the state. A nil
+// state is returned if the state is not set
+func StateFromProto(state *api.SchedulePolicyState) (*State, error) {
+	if state == nil {
+		return nil, nil
+	}
+	s := &State{
+		LastRun:       make(map[PolicyType]time.Time),
+		RunsSinceFull: make(map[PolicyType]uint64),
+	}
+	if state.AnchorTime != nil {
+		anchor, err := types.TimestampFromProto(state.AnchorTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid anchor time: %v", err)
+		}
+		s.Anchor = anchor
+	}
+	for policyType, lastRun := range state.LastRun {
+		if lastRun == nil {
+			continue
+		}
+		t, err := types.TimestampFromProto(lastRun)
+		if err != nil {
+			return nil, fmt.Errorf("invalid last run of the %v policy: %v", policyType, err)
+		}
+		s.LastRun[PolicyType(policyType)] = t
+	}
+	for policyType, runs := range state.RunsSinceFull {
+		s.RunsSinceFull[PolicyType(policyType)] = runs
+	}
+	return s, nil
+}
+
+// Next returns the next count runs of the policy after from, evaluated in
+// the location. At most MaxRuns runs are returned
+func (p *Policy) Next(loc *time.Location, from time.Time, count int, state *State) []*Run {
+	if count > MaxRuns {
+		count = MaxRuns
+	}
+	if count <= 0 {
+		return []*Run{}
+	}
+	runs := make([]*Run, 0)
+	for _, t := range p.triggers {
+		runs = append(runs, t.runs(loc, from, time.Time{}, count, state)...)
+	}
+	return sortRuns(runs, count)
+}
+
+// Between returns the runs of the policy after from and up to to,
+// evaluated in the location. At most limit runs are returned, or MaxRuns
+// if limit is not set
+func (p *Policy) Between(loc *time.Location, from time.Time, to time.Time, limit int, state *State) []*Run {
+	if limit <= 0 || limit > MaxRuns {
+		limit = MaxRuns
+	}
+	if !to.After(from) {
+		return []*Run{}
+	}
+	runs := make([]*Run, 0)
+	for _, t := range p.triggers {
+		runs = append(runs, t.runs(loc, from, to, limit, state)...)
+	}
+	return sortRuns(runs, limit)
+}
+
+// runs returns the runs of the policy after from, up to to if it is set,
+// and at most count of them. The runs needed for RetainedUntil are
+// evaluated beyond the limits but not returned. RetainedUntil is not set
+// for the policies for object lock
+func (t *trigger) runs(loc *time.Location, from time.Time, to time.Time, count int, state *State) []*Run {
+	if loc == nil {
+		loc = time.UTC
+	}
+	anchor := from
+	if state != nil && !state.Anchor.IsZero() {
+		anchor = state.Anchor
+	}
+	var runsSinceFull uint64
+	if state != nil {
+		runsSinceFull = state.RunsSinceFull[t.policyType]
+	}
+
+	retained := int(t.retain)
+	if t.objectLock {
+		retained = 0
+	}
+	times := make([]time.Time, 0)
+	inRange := 0
+	next := t.first(loc, from, state, anchor)
+	for !next.IsZero() && len(times) < count+retained {
+		if inRange < count && (to.IsZero() || !next.After(to)) {
+			inRange++
+		} else if len(times) >= inRange+retained {
+			break
+		}
+		times = append(times, next)
+		next = t.next(loc, next, anchor)
+	}
+
+	runs := make([]*Run, 0, inRange)
+	for i := 0; i < inRange; i++ {
+		run := &Run{
+			Time:       times[i],
+			PolicyType: t.policyType,
+			Retain:     t.retain,
+			Mode:       ModeIncremental,
+		}
+		if (runsSinceFull+uint64(i))%(t.incrementalCount+1) == 0 {
+			run.Mode = ModeFull
+		}
+		if retained > 0 && i+retained < len(times) {
+			run.RetainedUntil = times[i+retained]
+		}
+		runs = append(runs, run)
+	}
+	return runs
+}
+
+// first returns the first run after from. The interval policy triggers at
+// from if it has not run yet or is overdue
+func (t *trigger) first(loc *time.Location, from time.Time, state *State, anchor time.Time) time.Time {
+	if t.policyType != PolicyTypeInterval {
+		return t.next(loc, from, anchor)
+	}
+	if state != nil {
+		if lastRun, ok := state.LastRun[t.policyType]; ok && !lastRun.IsZero() {
+			if next := lastRun.Add(t.interval); next.After(from) {
+				return next
+			}
+		}
+	}
+	return from
+}
+
+// next returns the first run strictly after the time, or the zero time if
+// the policy never triggers again
+func (t *trigger) next(loc *time.Location, after time.Time, anchor time.Time) time.Time {
+	if t.policyType == PolicyTypeInterval {
+		return after.Add(t.interval)
+	}
+	local := after.In(loc)
+	year, month, day := local.Date()
+	switch t.policyType {
+	case PolicyTypeDaily:
+		for i := 0; i <= 1; i++ {
+			if next := t.at(loc, year, month, day+i); next.After(after) {
+				return next
+			}
+		}
+	case PolicyTypeWeekly:
+		for i := 0; i <= 14; i++ {
+			next := t.at(loc, year, month, day+i)
+			if !t.days[next.Weekday()] || !next.After(after) {
+				continue
+			}
+			if t.biWeekly && (weekIndex(next)-weekIndex(anchor.In(loc)))%2 != 0 {
+				continue
+			}
+			return next
+		}
+	case PolicyTypeMonthly:
+		for i := 0; i <= monthsToSearch; i++ {
+			first := time.Date(year, month+time.Month(i), 1, 0, 0, 0, 0, time.UTC)
+			if !t.relative && len(t.months) > 0 && !t.months[first.Month()] {
+				continue
+			}
+			date := t.date
+			if t.relative {
+				date = t.relativeDate(first.Year(), first.Month())
+			} else if date > daysIn(first.Year(), first.Month()) {
+				continue
+			}
+			if next := t.at(loc, first.Year(), first.Month(), date); next.After(after) {
+				return next
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// at returns the time of the policy on the date in the location. Dates
+// out of range are normalized by time.Date. A time skipped by a forward
+// transition of the location, like 2:30AM at the start of the daylight
+// saving time, is shifted forward by the length of the transition
+func (t *trigger) at(loc *time.Location, year int, month time.Month, day int) time.Time {
+	at := time.Date(year, month, day, t.hour, t.minute, 0, 0, loc)
+	wall := time.Date(year, month, day, t.hour, t.minute, 0, 0, time.UTC)
+	atYear, atMonth, atDay := at.Date()
+	atWall := time.Date(atYear, atMonth, atDay, at.Hour(), at.Minute(), 0, 0, time.UTC)
+	// time.Date may resolve a skipped time with the offset after the
+	// transition, which moves it before the transition
+	if atWall.Before(wall) {
+		at = at.Add(wall.Sub(atWall))
+	}
+	return at
+}
+
+// relativeDate returns the date of the weekday with the weekly index in
+// the month
+func (t *trigger) relativeDate(year int, month time.Month) int {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).Weekday()
+	date := 1 + (int(t.weekday)-int(first)+7)%7
+	if t.weeklyIndex != weeklyIndexLast {
+		return date + 7*(t.weeklyIndex-1)
+	}
+	for date+7 <= daysIn(year, month) {
+		date += 7
+	}
+	return date
+}
+
+// weekIndex returns the index of the week, starting on Sunday, of the
+// date of the time. It is independent of the offset of the location
+func weekIndex(t time.Time) int64 {
+	year, month, day := t.Date()
+	days := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / (24 * 60 * 60)
+	// 1 Jan 1970 was a Thursday
+	return floorDiv(days+4, 7)
+}
+
+func floorDiv(a int64, b int64) int64 {
+	q := a / b
+	if a%b != 0 && a < 0 {
+		q--
+	}
+	return q
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func sortRuns(runs []*Run, limit int) []*Run {
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].Time.Before(runs[j].Time)
+	})
+	if len(runs) > limit {
+		runs = runs[:limit]
+	}
+	return runs
+}
+
+// ToSchedulePolicyRun converts the run to its API representation
+func (r *Run) ToSchedulePolicyRun() *api.SchedulePolicyRun {
+	run := &api.SchedulePolicyRun{
+		PolicyType: string(r.PolicyType),
+		Retain:     r.Retain,
+		Mode:       api.SchedulePolicyRun_Incremental,
+	}
+	if r.Mode == ModeFull {
+		run.Mode = api.SchedulePolicyRun_Full
+	}
+	if triggerTime, err := types.TimestampProto(r.Time); err == nil {
+		run.TriggerTime = triggerTime
+	}
+	if !r.RetainedUntil.IsZero() {
+		if retainedUntil, err := types.TimestampProto(r.RetainedUntil); err == nil {
+			run.RetainedUntil = retainedUntil
+		}
+	}
+	return run
+}
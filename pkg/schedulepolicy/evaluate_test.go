@@ -0,0 +1,228 @@
+package schedulepolicy_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+	"github.com/portworx/px-backup-api/pkg/schedulepolicy"
+)
+
+const runLayout = "2006-01-02 15:04 MST"
+
+func relativeMonthly(day string, weeklyIndex api.SchedulePolicyInfo_MonthlyPolicy_RelativeMonthlyPolicyWeeklyIndex) *api.SchedulePolicyInfo {
+	return &api.SchedulePolicyInfo{
+		SupportsAdvancedFeatures: true,
+		Monthly: &api.SchedulePolicyInfo_MonthlyPolicy{
+			MonthlyPolicy: &api.SchedulePolicyInfo_MonthlyPolicy_RelativeMonthlyPolicy_{
+				RelativeMonthlyPolicy: &api.SchedulePolicyInfo_MonthlyPolicy_RelativeMonthlyPolicy{
+					Day:         day,
+					WeeklyIndex: weeklyIndex,
+					Time:        "10:00AM",
+				},
+			},
+		},
+	}
+}
+
+func selectiveMonthly(date int64, months string) *api.SchedulePolicyInfo {
+	return &api.SchedulePolicyInfo{
+		SupportsAdvancedFeatures: true,
+		Monthly: &api.SchedulePolicyInfo_MonthlyPolicy{
+			MonthlyPolicy: &api.SchedulePolicyInfo_MonthlyPolicy_SelectiveMonthlyPolicy_{
+				SelectiveMonthlyPolicy: &api.SchedulePolicyInfo_MonthlyPolicy_SelectiveMonthlyPolicy{
+					Date:   date,
+					Time:   "12:00AM",
+					Months: months,
+				},
+			},
+		},
+	}
+}
+
+func biWeekly(day string) *api.SchedulePolicyInfo {
+	return &api.SchedulePolicyInfo{
+		SupportsAdvancedFeatures: true,
+		Weekly:                   &api.SchedulePolicyInfo_WeeklyPolicy{Day: day, Time: "1:00AM", BiWeekly: true},
+	}
+}
+
+func daily(timeOfDay string) *api.SchedulePolicyInfo {
+	return &api.SchedulePolicyInfo{
+		Daily: &api.SchedulePolicyInfo_DailyPolicy{Time: timeOfDay},
+	}
+}
+
+func TestNext(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load the location: %v", err)
+	}
+	tests := []struct {
+		name     string
+		info     *api.SchedulePolicyInfo
+		loc      *time.Location
+		from     time.Time
+		state    *schedulepolicy.State
+		expected []string
+	}{
+		{
+			name: "fourth sunday",
+			info: relativeMonthly("sun", api.SchedulePolicyInfo_MonthlyPolicy_RelativeMonthlyPolicy_fourth),
+			loc:  time.UTC,
+			from: time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC),
+			// March 2026 has five Sundays
+			expected: []string{"2026-02-22 10:00 UTC", "2026-03-22 10:00 UTC"},
+		},
+		{
+			name:     "last sunday",
+			info:     relativeMonthly("sun", api.SchedulePolicyInfo_MonthlyPolicy_RelativeMonthlyPolicy_last),
+			loc:      time.UTC,
+			from:     time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC),
+			expected: []string{"2026-02-22 10:00 UTC", "2026-03-29 10:00 UTC"},
+		},
+		{
+			name: "bi-weekly in the week of from",
+			info: biWeekly("mon"),
+			loc:  time.UTC,
+			// Monday 5 January has passed, the week of 12 January is skipped
+			from:     time.Date(2026, time.January, 7, 0, 0, 0, 0, time.UTC),
+			expected: []string{"2026-01-19 01:00 UTC", "2026-02-02 01:00 UTC"},
+		},
+		{
+			name:     "bi-weekly with an anchor",
+			info:     biWeekly("mon"),
+			loc:      time.UTC,
+			from:     time.Date(2026, time.January, 7, 0, 0, 0, 0, time.UTC),
+			state:    &schedulepolicy.State{Anchor: time.Date(2025, time.December, 29, 1, 0, 0, 0, time.UTC)},
+			expected: []string{"2026-01-12 01:00 UTC", "2026-01-26 01:00 UTC"},
+		},
+		{
+			name: "bi-weekly anchor on a Saturday night in the location",
+			info: biWeekly("sun"),
+			loc:  newYork,
+			from: time.Date(2026, time.January, 1, 0, 0, 0, 0, newYork),
+			// 10 January 11PM in New York is 11 January in UTC
+			state:    &schedulepolicy.State{Anchor: time.Date(2026, time.January, 11, 4, 0, 0, 0, time.UTC)},
+			expected: []string{"2026-01-04 01:00 EST", "2026-01-18 01:00 EST"},
+		},
+		{
+			name: "daily at the start of the daylight saving time",
+			info: daily("2:30AM"),
+			loc:  newYork,
+			from: time.Date(2026, time.March, 7, 0, 0, 0, 0, newYork),
+			// 2:30AM is skipped on 8 March and shifted forward
+			expected: []string{"2026-03-07 02:30 EST", "2026-03-08 03:30 EDT", "2026-03-09 02:30 EDT"},
+		},
+		{
+			name: "daily at the end of the daylight saving time",
+			info: daily("1:30AM"),
+			loc:  newYork,
+			from: time.Date(2026, time.October, 31, 0, 0, 0, 0, newYork),
+			// 1:30AM occurs twice on 1 November and triggers once
+			expected: []string{"2026-10-31 01:30 EDT", "2026-11-01 01:30 EDT", "2026-11-02 01:30 EST"},
+		},
+		{
+			name:     "leap day",
+			info:     selectiveMonthly(29, "feb"),
+			loc:      time.UTC,
+			from:     time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expected: []string{"2028-02-29 00:00 UTC", "2032-02-29 00:00 UTC"},
+		},
+		{
+			name:     "date skipped in short months",
+			info:     selectiveMonthly(31, ""),
+			loc:      time.UTC,
+			from:     time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC),
+			expected: []string{"2026-05-31 00:00 UTC", "2026-07-31 00:00 UTC"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			policy, err := schedulepolicy.Parse(test.info)
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			runs := policy.Next(test.loc, test.from, len(test.expected), test.state)
+			actual := make([]string, 0, len(runs))
+			for _, run := range runs {
+				actual = append(actual, run.Time.In(test.loc).Format(runLayout))
+			}
+			if len(actual) != len(test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, actual)
+			}
+			for i := range actual {
+				if actual[i] != test.expected[i] {
+					t.Errorf("expected %v, got %v", test.expected, actual)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestNextFromState(t *testing.T) {
+	policy, err := schedulepolicy.Parse(&api.SchedulePolicyInfo{
+		Interval: &api.SchedulePolicyInfo_IntervalPolicy{
+			Minutes:          30,
+			Retain:           2,
+			IncrementalCount: &api.SchedulePolicyInfo_IncrementalCount{Count: 2},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	from := time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC)
+	lastRun, _ := types.TimestampProto(from.Add(-10 * time.Minute))
+	state, err := schedulepolicy.StateFromProto(&api.SchedulePolicyState{
+		LastRun:       map[string]*types.Timestamp{string(schedulepolicy.PolicyTypeInterval): lastRun},
+		RunsSinceFull: map[string]uint64{string(schedulepolicy.PolicyTypeInterval): 1},
+	})
+	if err != nil {
+		t.Fatalf("StateFromProto failed: %v", err)
+	}
+
+	runs := policy.Next(time.UTC, from, 3, state)
+	expected := []struct {
+		time          time.Time
+		mode          schedulepolicy.Mode
+		retainedUntil time.Time
+	}{
+		{from.Add(20 * time.Minute), schedulepolicy.ModeIncremental, from.Add(80 * time.Minute)},
+		{from.Add(50 * time.Minute), schedulepolicy.ModeIncremental, from.Add(110 * time.Minute)},
+		{from.Add(80 * time.Minute), schedulepolicy.ModeFull, from.Add(140 * time.Minute)},
+	}
+	if len(runs) != len(expected) {
+		t.Fatalf("expected %v runs, got %v", len(expected), len(runs))
+	}
+	for i, run := range runs {
+		if !run.Time.Equal(expected[i].time) || run.Mode != expected[i].mode || !run.RetainedUntil.Equal(expected[i].retainedUntil) {
+			t.Errorf("run %v: expected %+v, got %+v", i, expected[i], run)
+		}
+	}
+
+	if state, err := schedulepolicy.StateFromProto(nil); state != nil || err != nil {
+		t.Errorf("expected no state, got %v: %v", state, err)
+	}
+}
+
+func TestNextForObjectLock(t *testing.T) {
+	info := daily("1:00AM")
+	info.ForObjectLock = true
+	policy, err := schedulepolicy.Parse(info)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	from := time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC)
+	runs := policy.Next(time.UTC, from, 3, nil)
+	if len(runs) != 3 {
+		t.Fatalf("expected 3 runs, got %v", len(runs))
+	}
+	// The backups are kept for the lock period of the bucket
+	for i, run := range runs {
+		if !run.RetainedUntil.IsZero() {
+			t.Errorf("run %v: expected no retained until, got %v", i, run.RetainedUntil)
+		}
+	}
+}
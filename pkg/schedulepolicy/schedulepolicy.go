@@ -0,0 +1,271 @@
+// Package schedulepolicy parses and evaluates the schedule policies of the
+// backup schedules. It computes the times a policy triggers in a time zone,
+// the retention bucket each run is kept in and whether the run takes a full
+// or an incremental backup. It also validates and normalizes the schedule
+// policies before they are created
+package schedulepolicy
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+)
+
+// PolicyType is the type of a policy in a schedule policy. It is also the
+// key of the retention bucket the runs of the policy are kept in
+type PolicyType string
+
+const (
+	// PolicyTypeInterval triggers every configured number of minutes
+	PolicyTypeInterval PolicyType = "Interval"
+	// PolicyTypeDaily triggers every day at the configured time
+	PolicyTypeDaily PolicyType = "Daily"
+	// PolicyTypeWeekly triggers on the configured days of every week, or
+	// of every other week if bi-weekly
+	PolicyTypeWeekly PolicyType = "Weekly"
+	// PolicyTypeMonthly triggers on the configured date or relative day of
+	// the month
+	PolicyTypeMonthly PolicyType = "Monthly"
+)
+
+const (
+	// DefaultIntervalRetain is the retain count of the interval policy if
+	// none is set
+	DefaultIntervalRetain = 10
+	// DefaultDailyRetain is the retain count of the daily policy if none
+	// is set
+	DefaultDailyRetain = 10
+	// DefaultWeeklyRetain is the retain count of the weekly policy if none
+	// is set
+	DefaultWeeklyRetain = 5
+	// DefaultMonthlyRetain is the retain count of the monthly policy if
+	// none is set
+	DefaultMonthlyRetain = 12
+)
+
+// weeklyIndexLast is the index used for the last occurrence of a weekday
+// in a month
+const weeklyIndexLast = -1
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+var months = map[string]time.Month{
+	"jan": time.January, "january": time.January,
+	"feb": time.February, "february": time.February,
+	"mar": time.March, "march": time.March,
+	"apr": time.April, "april": time.April,
+	"may": time.May,
+	"jun": time.June, "june": time.June,
+	"jul": time.July, "july": time.July,
+	"aug": time.August, "august": time.August,
+	"sep": time.September, "september": time.September,
+	"oct": time.October, "october": time.October,
+	"nov": time.November, "november": time.November,
+	"dec": time.December, "december": time.December,
+}
+
+// Policy is a parsed schedule policy
+type Policy struct {
+	triggers []*trigger
+}
+
+// trigger is a parsed policy of a single policy type
+type trigger struct {
+	policyType       PolicyType
+	retain           int64
+	incrementalCount uint64
+	// objectLock is set for the policies for object lock, whose backups
+	// are kept for the lock period of the bucket instead of the retain
+	// count
+	objectLock bool
+
+	// interval is set for the interval policy
+	interval time.Duration
+
+	// hour and minute of the day the daily, weekly and monthly policies
+	// trigger at
+	hour   int
+	minute int
+
+	// days are set for the weekly policy
+	days     map[time.Weekday]bool
+	biWeekly bool
+
+	// date and months are set for the selective monthly policy. All the
+	// months are selected if months is empty
+	date   int
+	months map[time.Month]bool
+
+	// weekday and weeklyIndex are set for the relative monthly policy
+	weekday     time.Weekday
+	weeklyIndex int
+	relative    bool
+}
+
+// Parse parses the schedule policy. It only rejects the values which cannot
+// be parsed or evaluated, like an invalid time, day, month, date or weekly
+// index, so the existing policies created before the rules of Validate were
+// added are still evaluated. The errors are returned as FieldErrors
+func Parse(info *api.SchedulePolicyInfo) (*Policy, error) {
+	if err := validate(info, false).Err(); err != nil {
+		return nil, err
+	}
+	p := &Policy{}
+	if interval := info.GetInterval(); interval != nil {
+		p.triggers = append(p.triggers, &trigger{
+			policyType:       PolicyTypeInterval,
+			retain:           retainOrDefault(interval.GetRetain(), DefaultIntervalRetain),
+			incrementalCount: interval.GetIncrementalCount().GetCount(),
+			interval:         time.Duration(interval.GetMinutes()) * time.Minute,
+		})
+	}
+	if daily := info.GetDaily(); daily != nil {
+		hour, minute, _ := ParseTime(daily.GetTime())
+		p.triggers = append(p.triggers, &trigger{
+			policyType:       PolicyTypeDaily,
+			retain:           retainOrDefault(daily.GetRetain(), DefaultDailyRetain),
+			incrementalCount: daily.GetIncrementalCount().GetCount(),
+			hour:             hour,
+			minute:           minute,
+		})
+	}
+	if weekly := info.GetWeekly(); weekly != nil {
+		p.triggers = append(p.triggers, parseWeekly(weekly))
+	}
+	if monthly := info.GetMonthly(); monthly != nil {
+		p.triggers = append(p.triggers, parseMonthly(monthly))
+	}
+	for _, t := range p.triggers {
+		t.objectLock = info.GetForObjectLock()
+	}
+	return p, nil
+}
+
+// PolicyTypes returns the policy types set in the schedule policy
+func (p *Policy) PolicyTypes() []PolicyType {
+	policyTypes := make([]PolicyType, 0, len(p.triggers))
+	for _, t := range p.triggers {
+		policyTypes = append(policyTypes, t.policyType)
+	}
+	return policyTypes
+}
+
+// ParseTime parses a time of the day in the time.Kitchen format, for
+// example 12:04PM or 12:04pm, and returns its hour and minute
+func ParseTime(value string) (int, int, error) {
+	t, err := time.Parse(time.Kitchen, strings.ToUpper(strings.TrimSpace(value)))
+	if err != nil {
+		return 0, 0, fmt.Errorf("time %q is not in the time.Kitchen format, for example 12:04PM", value)
+	}
+	return t.Hour(), t.Minute(), nil
+}
+
+// ParseWeekday parses a day of the week, for example sun or Sunday
+func ParseWeekday(value string) (time.Weekday, error) {
+	day, ok := weekdays[strings.ToLower(strings.TrimSpace(value))]
+	if !ok {
+		return 0, fmt.Errorf("invalid day %q", value)
+	}
+	return day, nil
+}
+
+// ParseMonth parses a month, for example jan or January
+func ParseMonth(value string) (time.Month, error) {
+	month, ok := months[strings.ToLower(strings.TrimSpace(value))]
+	if !ok {
+		return 0, fmt.Errorf("invalid month %q", value)
+	}
+	return month, nil
+}
+
+// parseWeekly parses the weekly policy, which has been validated
+func parseWeekly(weekly *api.SchedulePolicyInfo_WeeklyPolicy) *trigger {
+	hour, minute, _ := ParseTime(weekly.GetTime())
+	t := &trigger{
+		policyType:       PolicyTypeWeekly,
+		retain:           retainOrDefault(weekly.GetRetain(), DefaultWeeklyRetain),
+		incrementalCount: weekly.GetIncrementalCount().GetCount(),
+		hour:             hour,
+		minute:           minute,
+		days:             make(map[time.Weekday]bool),
+		biWeekly:         weekly.GetBiWeekly(),
+	}
+	for _, value := range splitList(weekly.GetDay()) {
+		day, _ := ParseWeekday(value)
+		t.days[day] = true
+	}
+	return t
+}
+
+// parseMonthly parses the monthly policy, which has been validated
+func parseMonthly(monthly *api.SchedulePolicyInfo_MonthlyPolicy) *trigger {
+	if relative := monthly.GetRelativeMonthlyPolicy(); relative != nil {
+		hour, minute, _ := ParseTime(relative.GetTime())
+		weekday, _ := ParseWeekday(relative.GetDay())
+		weeklyIndex := int(relative.GetWeeklyIndex())
+		if relative.GetWeeklyIndex() == api.SchedulePolicyInfo_MonthlyPolicy_RelativeMonthlyPolicy_last {
+			weeklyIndex = weeklyIndexLast
+		}
+		return &trigger{
+			policyType:       PolicyTypeMonthly,
+			retain:           retainOrDefault(relative.GetRetain(), DefaultMonthlyRetain),
+			incrementalCount: relative.GetIncrementalCount().GetCount(),
+			hour:             hour,
+			minute:           minute,
+			weekday:          weekday,
+			weeklyIndex:      weeklyIndex,
+			relative:         true,
+		}
+	}
+
+	// The deprecated date and time fields are used by the policies created
+	// before the selective and relative monthly policies were added
+	date, timeOfDay, retain, incrementalCount, monthList :=
+		monthly.GetDate(), monthly.GetTime(), monthly.GetRetain(), monthly.GetIncrementalCount(), ""
+	if selective := monthly.GetSelectiveMonthlyPolicy(); selective != nil {
+		date, timeOfDay, retain, incrementalCount, monthList =
+			selective.GetDate(), selective.GetTime(), selective.GetRetain(), selective.GetIncrementalCount(), selective.GetMonths()
+	}
+	hour, minute, _ := ParseTime(timeOfDay)
+	t := &trigger{
+		policyType:       PolicyTypeMonthly,
+		retain:           retainOrDefault(retain, DefaultMonthlyRetain),
+		incrementalCount: incrementalCount.GetCount(),
+		hour:             hour,
+		minute:           minute,
+		date:             int(date),
+		months:           make(map[time.Month]bool),
+	}
+	for _, value := range splitList(monthList) {
+		month, _ := ParseMonth(value)
+		t.months[month] = true
+	}
+	return t
+}
+
+func splitList(value string) []string {
+	values := make([]string, 0)
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+func retainOrDefault(retain int64, defaultRetain int64) int64 {
+	if retain <= 0 {
+		return defaultRetain
+	}
+	return retain
+}
@@ -0,0 +1,305 @@
+package schedulepolicy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+)
+
+// FieldError is a validation error of a field of a schedule policy
+type FieldError struct {
+	// Field is the path of the invalid field in the schedule policy, for
+	// example monthly.selective_monthly_policy.months
+	Field string
+	// Message describes the error
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// FieldErrors are the validation errors of a schedule policy
+type FieldErrors []*FieldError
+
+func (f FieldErrors) Error() string {
+	messages := make([]string, 0, len(f))
+	for _, err := range f {
+		messages = append(messages, err.Error())
+	}
+	return fmt.Sprintf("invalid schedule policy: %v", strings.Join(messages, "; "))
+}
+
+// Err returns the field errors as an error, or nil if there are none
+func (f FieldErrors) Err() error {
+	if len(f) == 0 {
+		return nil
+	}
+	return f
+}
+
+func (f *FieldErrors) add(field string, format string, args ...interface{}) {
+	*f = append(*f, &FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// Validate returns the errors of the schedule policy. Besides the values
+// which cannot be parsed, it rejects the combinations of fields which
+// contradict each other:
+//   - the deprecated monthly fields set along with the monthly_policy oneof
+//   - multiple weekly days, bi-weekly, relative and selective monthly
+//     policies without supports_advanced_features
+//   - a retain count other than the default retain count of the policy
+//     type with for_object_lock, as the backups of object lock policies are
+//     kept for the lock period of the bucket instead
+//
+// Validate can be run by the clients before SchedulePolicy.Create
+func Validate(info *api.SchedulePolicyInfo) FieldErrors {
+	return validate(info, true)
+}
+
+// validate returns the errors of the values of the schedule policy which
+// cannot be parsed or evaluated, and of the rules of Validate if rules is
+// set
+func validate(info *api.SchedulePolicyInfo, rules bool) FieldErrors {
+	errs := make(FieldErrors, 0)
+	if info == nil {
+		errs.add("", "schedule policy not provided")
+		return errs
+	}
+	if info.GetInterval() == nil && info.GetDaily() == nil && info.GetWeekly() == nil && info.GetMonthly() == nil {
+		errs.add("", "one of interval, daily, weekly or monthly must be set")
+		return errs
+	}
+	if interval := info.GetInterval(); interval != nil {
+		if interval.GetMinutes() <= 0 {
+			errs.add("interval.minutes", "must be greater than 0")
+		}
+		validateRetain(&errs, info, rules, "interval.retain", interval.GetRetain(), DefaultIntervalRetain)
+	}
+	if daily := info.GetDaily(); daily != nil {
+		validateTime(&errs, "daily.time", daily.GetTime())
+		validateRetain(&errs, info, rules, "daily.retain", daily.GetRetain(), DefaultDailyRetain)
+	}
+	if weekly := info.GetWeekly(); weekly != nil {
+		validateWeekly(&errs, info, rules, weekly)
+	}
+	if monthly := info.GetMonthly(); monthly != nil {
+		validateMonthly(&errs, info, rules, monthly)
+	}
+	return errs
+}
+
+// Normalize validates the schedule policy and returns a copy of it with
+// the times in the time.Kitchen format, like 2:04AM, and the days and
+// months as comma separated abbreviations in calendar order, like Sun,Wed
+func Normalize(info *api.SchedulePolicyInfo) (*api.SchedulePolicyInfo, error) {
+	if err := Validate(info).Err(); err != nil {
+		return nil, err
+	}
+	normalized := proto.Clone(info).(*api.SchedulePolicyInfo)
+	if daily := normalized.GetDaily(); daily != nil {
+		daily.Time = normalizeTime(daily.Time)
+	}
+	if weekly := normalized.GetWeekly(); weekly != nil {
+		weekly.Time = normalizeTime(weekly.Time)
+		weekly.Day = normalizeDays(weekly.Day)
+	}
+	if monthly := normalized.GetMonthly(); monthly != nil {
+		if monthly.Time != "" {
+			monthly.Time = normalizeTime(monthly.Time)
+		}
+		if selective := monthly.GetSelectiveMonthlyPolicy(); selective != nil {
+			selective.Time = normalizeTime(selective.Time)
+			selective.Months = normalizeMonths(selective.Months)
+		}
+		if relative := monthly.GetRelativeMonthlyPolicy(); relative != nil {
+			relative.Time = normalizeTime(relative.Time)
+			relative.Day = normalizeDays(relative.Day)
+		}
+	}
+	return normalized, nil
+}
+
+func validateWeekly(errs *FieldErrors, info *api.SchedulePolicyInfo, rules bool, weekly *api.SchedulePolicyInfo_WeeklyPolicy) {
+	validateTime(errs, "weekly.time", weekly.GetTime())
+	validateRetain(errs, info, rules, "weekly.retain", weekly.GetRetain(), DefaultWeeklyRetain)
+	days := splitList(weekly.GetDay())
+	if len(days) == 0 {
+		errs.add("weekly.day", "must be set")
+	}
+	seen := make(map[time.Weekday]bool)
+	for _, value := range days {
+		day, err := ParseWeekday(value)
+		if err != nil {
+			errs.add("weekly.day", "%v, expected a day like sun or sunday", err)
+			continue
+		}
+		if rules && seen[day] {
+			errs.add("weekly.day", "day %v is repeated", day)
+		}
+		seen[day] = true
+	}
+	if !rules {
+		return
+	}
+	if len(days) > 1 && !info.GetSupportsAdvancedFeatures() {
+		errs.add("weekly.day", "multiple days require supports_advanced_features")
+	}
+	if weekly.GetBiWeekly() && !info.GetSupportsAdvancedFeatures() {
+		errs.add("weekly.bi_weekly", "requires supports_advanced_features")
+	}
+}
+
+func validateMonthly(errs *FieldErrors, info *api.SchedulePolicyInfo, rules bool, monthly *api.SchedulePolicyInfo_MonthlyPolicy) {
+	relative := monthly.GetRelativeMonthlyPolicy()
+	selective := monthly.GetSelectiveMonthlyPolicy()
+	if relative == nil && selective == nil {
+		if monthly.GetDate() < 1 || monthly.GetDate() > 31 {
+			errs.add("monthly.date", "must be between 1 and 31")
+		}
+		validateTime(errs, "monthly.time", monthly.GetTime())
+		validateRetain(errs, info, rules, "monthly.retain", monthly.GetRetain(), DefaultMonthlyRetain)
+		return
+	}
+
+	oneof := "monthly.selective_monthly_policy"
+	if relative != nil {
+		oneof = "monthly.relative_monthly_policy"
+	}
+	for _, deprecated := range []struct {
+		field string
+		set   bool
+	}{
+		{"monthly.date", monthly.GetDate() != 0},
+		{"monthly.time", monthly.GetTime() != ""},
+		{"monthly.retain", monthly.GetRetain() != 0},
+		{"monthly.incremental_count", monthly.GetIncrementalCount() != nil},
+	} {
+		if rules && deprecated.set {
+			errs.add(deprecated.field, "deprecated field cannot be set along with %v", oneof)
+		}
+	}
+	if rules && !info.GetSupportsAdvancedFeatures() {
+		errs.add(oneof, "requires supports_advanced_features")
+	}
+
+	if relative != nil {
+		validateTime(errs, oneof+".time", relative.GetTime())
+		validateRetain(errs, info, rules, oneof+".retain", relative.GetRetain(), DefaultMonthlyRetain)
+		if days := splitList(relative.GetDay()); len(days) != 1 {
+			errs.add(oneof+".day", "exactly one day must be set")
+		} else if _, err := ParseWeekday(days[0]); err != nil {
+			errs.add(oneof+".day", "%v, expected a day like sun or sunday", err)
+		}
+		if _, ok := api.SchedulePolicyInfo_MonthlyPolicy_RelativeMonthlyPolicyWeeklyIndex_name[int32(relative.GetWeeklyIndex())]; !ok ||
+			relative.GetWeeklyIndex() == api.SchedulePolicyInfo_MonthlyPolicy_RelativeMonthlyPolicy_Invalid {
+			errs.add(oneof+".weekly_index", "must be one of first, second, third, fourth or last")
+		}
+		return
+	}
+
+	validateTime(errs, oneof+".time", selective.GetTime())
+	validateRetain(errs, info, rules, oneof+".retain", selective.GetRetain(), DefaultMonthlyRetain)
+	date := selective.GetDate()
+	if date < 1 || date > 31 {
+		errs.add(oneof+".date", "must be between 1 and 31")
+	}
+	selected := make(map[time.Month]bool)
+	for _, value := range splitList(selective.GetMonths()) {
+		month, err := ParseMonth(value)
+		if err != nil {
+			errs.add(oneof+".months", "%v, expected a month like jan or january", err)
+			continue
+		}
+		if rules && selected[month] {
+			errs.add(oneof+".months", "month %v is repeated", month)
+		}
+		selected[month] = true
+	}
+	if rules && date >= 1 && date <= 31 && len(selected) > 0 {
+		occurs := false
+		for month := range selected {
+			// 2000 is a leap year, so 29 Feb is counted as occurring
+			if int(date) <= daysIn(2000, month) {
+				occurs = true
+				break
+			}
+		}
+		if !occurs {
+			errs.add(oneof+".date", "date %v does not occur in any of the selected months", date)
+		}
+	}
+}
+
+func validateTime(errs *FieldErrors, field string, value string) {
+	if _, _, err := ParseTime(value); err != nil {
+		errs.add(field, "%v", err)
+	}
+}
+
+// validateRetain checks the retain count against the rules of Validate.
+// The retain counts which are not set or negative are parsed as the
+// default retain count. The object lock policies only allow the retain
+// counts which are parsed as the default, as the clients may set it
+func validateRetain(errs *FieldErrors, info *api.SchedulePolicyInfo, rules bool, field string, retain int64, defaultRetain int64) {
+	if !rules {
+		return
+	}
+	if retain < 0 {
+		errs.add(field, "must not be negative")
+		return
+	}
+	if info.GetForObjectLock() && retain != 0 && retain != defaultRetain {
+		errs.add(field, "retain %v cannot be set with for_object_lock, the backups are kept for the lock period of the bucket, "+
+			"leave it unset or set it to the default %v", retain, defaultRetain)
+	}
+}
+
+func normalizeTime(value string) string {
+	hour, minute, err := ParseTime(value)
+	if err != nil {
+		return value
+	}
+	return time.Date(0, 1, 1, hour, minute, 0, 0, time.UTC).Format(time.Kitchen)
+}
+
+func normalizeDays(value string) string {
+	seen := make(map[time.Weekday]bool)
+	days := make([]time.Weekday, 0)
+	for _, v := range splitList(value) {
+		if day, err := ParseWeekday(v); err == nil && !seen[day] {
+			seen[day] = true
+			days = append(days, day)
+		}
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i] < days[j] })
+	names := make([]string, 0, len(days))
+	for _, day := range days {
+		names = append(names, day.String()[:3])
+	}
+	return strings.Join(names, ",")
+}
+
+func normalizeMonths(value string) string {
+	seen := make(map[time.Month]bool)
+	selected := make([]time.Month, 0)
+	for _, v := range splitList(value) {
+		if month, err := ParseMonth(v); err == nil && !seen[month] {
+			seen[month] = true
+			selected = append(selected, month)
+		}
+	}
+	sort.Slice(selected, func(i, j int) bool { return selected[i] < selected[j] })
+	names := make([]string, 0, len(selected))
+	for _, month := range selected {
+		names = append(names, month.String()[:3])
+	}
+	return strings.Join(names, ",")
+}
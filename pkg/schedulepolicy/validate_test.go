@@ -0,0 +1,232 @@
+package schedulepolicy_test
+
+import (
+	"errors"
+	"testing"
+
+	api "github.com/portworx/px-backup-api/pkg/apis/v1"
+	"github.com/portworx/px-backup-api/pkg/schedulepolicy"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		info     *api.SchedulePolicyInfo
+		expected []string
+	}{
+		{
+			name:     "not provided",
+			info:     nil,
+			expected: []string{""},
+		},
+		{
+			name:     "no policy",
+			info:     &api.SchedulePolicyInfo{},
+			expected: []string{""},
+		},
+		{
+			name: "deprecated monthly fields",
+			info: &api.SchedulePolicyInfo{
+				Monthly: &api.SchedulePolicyInfo_MonthlyPolicy{Date: 5, Time: "1:00AM", Retain: 3},
+			},
+			expected: []string{},
+		},
+		{
+			name: "deprecated monthly fields with the oneof",
+			info: func() *api.SchedulePolicyInfo {
+				info := selectiveMonthly(5, "jan")
+				info.Monthly.Date = 5
+				info.Monthly.Time = "1:00AM"
+				info.Monthly.IncrementalCount = &api.SchedulePolicyInfo_IncrementalCount{Count: 1}
+				return info
+			}(),
+			expected: []string{"monthly.date", "monthly.time", "monthly.incremental_count"},
+		},
+		{
+			name: "relative monthly without advanced features",
+			info: func() *api.SchedulePolicyInfo {
+				info := relativeMonthly("sun", api.SchedulePolicyInfo_MonthlyPolicy_RelativeMonthlyPolicy_first)
+				info.SupportsAdvancedFeatures = false
+				return info
+			}(),
+			expected: []string{"monthly.relative_monthly_policy"},
+		},
+		{
+			name: "for_object_lock with retain",
+			info: &api.SchedulePolicyInfo{
+				ForObjectLock: true,
+				Interval:      &api.SchedulePolicyInfo_IntervalPolicy{Minutes: 15},
+				Daily:         &api.SchedulePolicyInfo_DailyPolicy{Time: "1:00AM", Retain: 7},
+			},
+			expected: []string{"daily.retain"},
+		},
+		{
+			name: "for_object_lock with the default retain",
+			info: &api.SchedulePolicyInfo{
+				ForObjectLock: true,
+				Interval:      &api.SchedulePolicyInfo_IntervalPolicy{Minutes: 15},
+				Daily:         &api.SchedulePolicyInfo_DailyPolicy{Time: "1:00AM", Retain: schedulepolicy.DefaultDailyRetain},
+			},
+			expected: []string{},
+		},
+		{
+			name: "for_object_lock with relative monthly retain",
+			info: func() *api.SchedulePolicyInfo {
+				info := relativeMonthly("sun", api.SchedulePolicyInfo_MonthlyPolicy_RelativeMonthlyPolicy_last)
+				info.ForObjectLock = true
+				info.Monthly.GetRelativeMonthlyPolicy().Retain = 2
+				return info
+			}(),
+			expected: []string{"monthly.relative_monthly_policy.retain"},
+		},
+		{
+			name: "repeated days",
+			info: &api.SchedulePolicyInfo{
+				SupportsAdvancedFeatures: true,
+				Weekly:                   &api.SchedulePolicyInfo_WeeklyPolicy{Day: "mon,Wed,monday", Time: "1:00AM"},
+			},
+			expected: []string{"weekly.day"},
+		},
+		{
+			name: "multiple days without advanced features",
+			info: &api.SchedulePolicyInfo{
+				Weekly: &api.SchedulePolicyInfo_WeeklyPolicy{Day: "mon,wed", Time: "1:00AM", BiWeekly: true},
+			},
+			expected: []string{"weekly.day", "weekly.bi_weekly"},
+		},
+		{
+			name:     "repeated months",
+			info:     selectiveMonthly(1, "jan,mar,January"),
+			expected: []string{"monthly.selective_monthly_policy.months"},
+		},
+		{
+			name:     "unknown month",
+			info:     selectiveMonthly(1, "jan,smarch"),
+			expected: []string{"monthly.selective_monthly_policy.months"},
+		},
+		{
+			name:     "date in none of the selected months",
+			info:     selectiveMonthly(31, "feb,apr,jun"),
+			expected: []string{"monthly.selective_monthly_policy.date"},
+		},
+		{
+			name:     "date in one of the selected months",
+			info:     selectiveMonthly(31, "feb,jul"),
+			expected: []string{},
+		},
+		{
+			name:     "leap day",
+			info:     selectiveMonthly(29, "feb"),
+			expected: []string{},
+		},
+		{
+			name: "invalid values",
+			info: &api.SchedulePolicyInfo{
+				Interval: &api.SchedulePolicyInfo_IntervalPolicy{Minutes: 0, Retain: -1},
+				Daily:    &api.SchedulePolicyInfo_DailyPolicy{Time: "25:00"},
+			},
+			expected: []string{"interval.minutes", "interval.retain", "daily.time"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := schedulepolicy.Validate(test.info)
+			actual := make([]string, 0, len(errs))
+			for _, err := range errs {
+				actual = append(actual, err.Field)
+			}
+			if len(actual) != len(test.expected) {
+				t.Fatalf("expected errors on %q, got %v", test.expected, errs)
+			}
+			for i := range actual {
+				if actual[i] != test.expected[i] {
+					t.Errorf("expected errors on %q, got %v", test.expected, errs)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		info     *api.SchedulePolicyInfo
+		expected []string
+	}{
+		{
+			name:     "invalid time",
+			info:     daily("25:00"),
+			expected: []string{"daily.time"},
+		},
+		{
+			name:     "invalid day and weekly index",
+			info:     relativeMonthly("someday", api.SchedulePolicyInfo_MonthlyPolicy_RelativeMonthlyPolicy_Invalid),
+			expected: []string{"monthly.relative_monthly_policy.day", "monthly.relative_monthly_policy.weekly_index"},
+		},
+		{
+			name:     "invalid date and month",
+			info:     selectiveMonthly(32, "jan,smarch"),
+			expected: []string{"monthly.selective_monthly_policy.date", "monthly.selective_monthly_policy.months"},
+		},
+		// The policies breaking the rules of Validate are parsed
+		{
+			name:     "date in none of the selected months",
+			info:     selectiveMonthly(30, "feb"),
+			expected: []string{},
+		},
+		{
+			name: "multiple days without advanced features",
+			info: &api.SchedulePolicyInfo{
+				Weekly: &api.SchedulePolicyInfo_WeeklyPolicy{Day: "mon,wed,mon", Time: "1:00AM", BiWeekly: true},
+			},
+			expected: []string{},
+		},
+		{
+			name: "for_object_lock with retain",
+			info: &api.SchedulePolicyInfo{
+				ForObjectLock: true,
+				Daily:         &api.SchedulePolicyInfo_DailyPolicy{Time: "1:00AM", Retain: 7},
+			},
+			expected: []string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := schedulepolicy.Parse(test.info)
+			if len(test.expected) == 0 {
+				if err != nil {
+					t.Fatalf("Parse failed: %v", err)
+				}
+				return
+			}
+			var errs schedulepolicy.FieldErrors
+			if !errors.As(err, &errs) || len(errs) != len(test.expected) {
+				t.Fatalf("expected errors on %q, got %v", test.expected, err)
+			}
+			for i := range errs {
+				if errs[i].Field != test.expected[i] {
+					t.Errorf("expected errors on %q, got %v", test.expected, errs)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	info := &api.SchedulePolicyInfo{
+		SupportsAdvancedFeatures: true,
+		Weekly:                   &api.SchedulePolicyInfo_WeeklyPolicy{Day: "friday, mon", Time: "2:05am"},
+	}
+	normalized, err := schedulepolicy.Normalize(info)
+	if err != nil {
+		t.Fatalf("Normalize failed: %v", err)
+	}
+	if normalized.Weekly.Day != "Mon,Fri" || normalized.Weekly.Time != "2:05AM" {
+		t.Errorf("unexpected normalized policy %v", normalized.Weekly)
+	}
+	if info.Weekly.Day != "friday, mon" {
+		t.Errorf("the policy was modified: %v", info.Weekly)
+	}
+}